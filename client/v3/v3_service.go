@@ -46,7 +46,46 @@ type Service interface {
 	GetNetworkSecurityRule(UUID string) (*NetworkSecurityRuleIntentResponse, error)
 	DeleteNetworkSecurityRule(UUID string) error
 	CreateNetworkSecurityRule(request *NetworkSecurityRuleIntentInput) (*NetworkSecurityRuleIntentResponse, error)
+	GetCluster(UUID string) (*ClusterIntentResource, error)
 	ListCluster(getEntitiesRequest *ClusterListMetadataOutput) (*ClusterListIntentResponse, error)
+	UpdateCluster(UUID string, body *ClusterIntentInput) (*ClusterIntentResource, error)
+	CreateVolumeGroup(request *VolumeGroupInput) (*VolumeGroupResponse, error)
+	DeleteVolumeGroup(UUID string) error
+	GetVolumeGroup(UUID string) (*VolumeGroupResponse, error)
+	ListVolumeGroup(getEntitiesRequest *ListMetadata) (*VolumeGroupListResponse, error)
+	UpdateVolumeGroup(UUID string, body *VolumeGroupInput) (*VolumeGroupResponse, error)
+	CreateProject(request *ProjectInput) (*ProjectResponse, error)
+	DeleteProject(UUID string) error
+	GetProject(UUID string) (*ProjectResponse, error)
+	ListProject(getEntitiesRequest *ListMetadata) (*ProjectListResponse, error)
+	UpdateProject(UUID string, body *ProjectInput) (*ProjectResponse, error)
+	CreateRole(request *RoleInput) (*RoleResponse, error)
+	DeleteRole(UUID string) error
+	GetRole(UUID string) (*RoleResponse, error)
+	ListRole(getEntitiesRequest *ListMetadata) (*RoleListResponse, error)
+	UpdateRole(UUID string, body *RoleInput) (*RoleResponse, error)
+	GetPermission(UUID string) (*PermissionResponse, error)
+	ListPermission(getEntitiesRequest *ListMetadata) (*PermissionListResponse, error)
+	CreateAccessControlPolicy(request *AccessControlPolicyInput) (*AccessControlPolicyResponse, error)
+	DeleteAccessControlPolicy(UUID string) error
+	GetAccessControlPolicy(UUID string) (*AccessControlPolicyResponse, error)
+	ListAccessControlPolicy(getEntitiesRequest *ListMetadata) (*AccessControlPolicyListResponse, error)
+	UpdateAccessControlPolicy(UUID string, body *AccessControlPolicyInput) (*AccessControlPolicyResponse, error)
+	CreateUser(request *UserInput) (*UserResponse, error)
+	DeleteUser(UUID string) error
+	GetUser(UUID string) (*UserResponse, error)
+	ListUser(getEntitiesRequest *ListMetadata) (*UserListResponse, error)
+	UpdateUser(UUID string, body *UserInput) (*UserResponse, error)
+	CreateUserGroup(request *UserGroupInput) (*UserGroupResponse, error)
+	DeleteUserGroup(UUID string) error
+	GetUserGroup(UUID string) (*UserGroupResponse, error)
+	ListUserGroup(getEntitiesRequest *ListMetadata) (*UserGroupListResponse, error)
+	GetDirectoryService(UUID string) (*DirectoryServiceResponse, error)
+	ListDirectoryService(getEntitiesRequest *ListMetadata) (*DirectoryServiceListResponse, error)
+	GetHost(UUID string) (*HostResponse, error)
+	ListHost(getEntitiesRequest *ListMetadata) (*HostListResponse, error)
+	CloneVM(UUID string, body *VMCloneInput) (*TaskReference, error)
+	GetTask(UUID string) (*Task, error)
 }
 
 /*CreateVM Creates a VM
@@ -421,27 +460,54 @@ func (op Operations) UpdateImage(UUID string, body *ImageIntentInput) (*ImageInt
  * This operation gets a CLUSTER.
  *
  * @param uuid The UUID of the entity.
- * @return *ImageIntentResponse
+ * @return *ClusterIntentResource
+ */
+func (op Operations) GetCluster(UUID string) (*ClusterIntentResource, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/clusters/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterIntentResponse := new(ClusterIntentResource)
+
+	err = op.client.Do(ctx, req, clusterIntentResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return clusterIntentResponse, nil
+}
+
+/*UpdateCluster updates a CLUSTER
+ * This operation submits a request to update a CLUSTER based on the input parameters.
+ *
+ * @param uuid The UUID of the entity.
+ * @param body
+ * @return *ClusterIntentResource
  */
-// func (op Operations) GetCluster(UUID string) (*ImageIntentResponse, error) {
-// 	ctx := context.TODO()
+func (op Operations) UpdateCluster(UUID string, body *ClusterIntentInput) (*ClusterIntentResource, error) {
+	ctx := context.TODO()
 
-// 	path := fmt.Sprintf("/images/%s", UUID)
+	path := fmt.Sprintf("/clusters/%s", UUID)
 
-// 	req, err := op.client.NewRequest(ctx, http.MethodGet, path, nil)
-// 	if err != nil {
-// 		return nil, err
-// 	}
+	req, err := op.client.NewRequest(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return nil, err
+	}
 
-// 	imageIntentResponse := new(ImageIntentResponse)
+	clusterIntentResponse := new(ClusterIntentResource)
 
-// 	err = op.client.Do(ctx, req, imageIntentResponse)
-// 	if err != nil {
-// 		return nil, err
-// 	}
+	err = op.client.Do(ctx, req, clusterIntentResponse)
+	if err != nil {
+		return nil, err
+	}
 
-// 	return imageIntentResponse, nil
-// }
+	return clusterIntentResponse, nil
+}
 
 /*ListCluster gets a list of CLUSTERS
  * This operation gets a list of CLUSTERS, allowing for sorting and pagination. Note: Entities that have not been created successfully are not listed.
@@ -469,33 +535,6 @@ func (op Operations) ListCluster(getEntitiesRequest *ClusterListMetadataOutput)
 	return clusterList, nil
 }
 
-/*UpdateImage updates a CLUSTER
- * This operation submits a request to update a CLUSTER based on the input parameters.
- *
- * @param uuid The UUID of the entity.
- * @param body
- * @return *ImageIntentResponse
- */
-// func (op Operations) UpdateImage(UUID string, body *ImageIntentInput) (*ImageIntentResponse, error) {
-// 	ctx := context.TODO()
-
-// 	path := fmt.Sprintf("/images/%s", UUID)
-
-// 	req, err := op.client.NewRequest(ctx, http.MethodPut, path, body)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	imageIntentResponse := new(ImageIntentResponse)
-
-// 	err = op.client.Do(ctx, req, imageIntentResponse)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return imageIntentResponse, nil
-// }
-
 //CreateOrUpdateCategoryKey ...
 func (op Operations) CreateOrUpdateCategoryKey(body *CategoryKey) (*CategoryKeyStatus, error) {
 	ctx := context.TODO()
@@ -820,3 +859,908 @@ func (op Operations) UpdateNetworkSecurityRule(UUID string, body *NetworkSecurit
 
 	return networkSecurityRuleIntentResponse, nil
 }
+
+/*CreateVolumeGroup Creates a Volume group
+ * This operation submits a request to create a Volume group based on the input parameters.
+ *
+ * @param request
+ * @return *VolumeGroupResponse
+ */
+func (op Operations) CreateVolumeGroup(request *VolumeGroupInput) (*VolumeGroupResponse, error) {
+	ctx := context.TODO()
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, "/volume_groups", request)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeGroupResponse := new(VolumeGroupResponse)
+
+	err = op.client.Do(ctx, req, volumeGroupResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return volumeGroupResponse, nil
+}
+
+/*DeleteVolumeGroup Deletes a Volume group
+ * This operation submits a request to delete a Volume group.
+ *
+ * @param UUID The UUID of the entity.
+ * @return void
+ */
+func (op Operations) DeleteVolumeGroup(UUID string) error {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/volume_groups/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+
+	return op.client.Do(ctx, req, nil)
+}
+
+/*GetVolumeGroup Gets a Volume group
+ * This operation gets a Volume group.
+ *
+ * @param UUID The UUID of the entity.
+ * @return *VolumeGroupResponse
+ */
+func (op Operations) GetVolumeGroup(UUID string) (*VolumeGroupResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/volume_groups/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeGroupResponse := new(VolumeGroupResponse)
+
+	err = op.client.Do(ctx, req, volumeGroupResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return volumeGroupResponse, nil
+}
+
+/*ListVolumeGroup Gets all volume groups
+ * This operation gets a list of Volume groups, allowing for sorting and pagination. Note: Entities that have not been created successfully are not listed.
+ *
+ * @param getEntitiesRequest
+ * @return *VolumeGroupListResponse
+ */
+func (op Operations) ListVolumeGroup(getEntitiesRequest *ListMetadata) (*VolumeGroupListResponse, error) {
+	ctx := context.TODO()
+	path := "/volume_groups/list"
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, path, getEntitiesRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeGroupListResponse := new(VolumeGroupListResponse)
+
+	err = op.client.Do(ctx, req, volumeGroupListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return volumeGroupListResponse, nil
+}
+
+/*UpdateVolumeGroup Updates a Volume group
+ * This operation submits a request to update a Volume group based on the input parameters.
+ *
+ * @param uuid The UUID of the entity.
+ * @param body
+ * @return *VolumeGroupResponse
+ */
+func (op Operations) UpdateVolumeGroup(UUID string, body *VolumeGroupInput) (*VolumeGroupResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/volume_groups/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeGroupResponse := new(VolumeGroupResponse)
+
+	err = op.client.Do(ctx, req, volumeGroupResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return volumeGroupResponse, nil
+}
+
+/*CreateProject Creates a Project
+ * This operation submits a request to create a Project based on the input parameters.
+ *
+ * @param request
+ * @return *ProjectResponse
+ */
+func (op Operations) CreateProject(request *ProjectInput) (*ProjectResponse, error) {
+	ctx := context.TODO()
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, "/projects", request)
+	if err != nil {
+		return nil, err
+	}
+
+	projectResponse := new(ProjectResponse)
+
+	err = op.client.Do(ctx, req, projectResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectResponse, nil
+}
+
+/*DeleteProject Deletes a Project
+ * This operation submits a request to delete a Project.
+ *
+ * @param UUID The UUID of the entity.
+ * @return void
+ */
+func (op Operations) DeleteProject(UUID string) error {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/projects/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+
+	return op.client.Do(ctx, req, nil)
+}
+
+/*GetProject Gets a Project
+ * This operation gets a Project.
+ *
+ * @param UUID The UUID of the entity.
+ * @return *ProjectResponse
+ */
+func (op Operations) GetProject(UUID string) (*ProjectResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/projects/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	projectResponse := new(ProjectResponse)
+
+	err = op.client.Do(ctx, req, projectResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectResponse, nil
+}
+
+/*ListProject Gets all projects
+ * This operation gets a list of Projects, allowing for sorting and pagination. Note: Entities that have not been created successfully are not listed.
+ *
+ * @param getEntitiesRequest
+ * @return *ProjectListResponse
+ */
+func (op Operations) ListProject(getEntitiesRequest *ListMetadata) (*ProjectListResponse, error) {
+	ctx := context.TODO()
+	path := "/projects/list"
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, path, getEntitiesRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	projectListResponse := new(ProjectListResponse)
+
+	err = op.client.Do(ctx, req, projectListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectListResponse, nil
+}
+
+/*UpdateProject Updates a Project
+ * This operation submits a request to update a Project based on the input parameters.
+ *
+ * @param uuid The UUID of the entity.
+ * @param body
+ * @return *ProjectResponse
+ */
+func (op Operations) UpdateProject(UUID string, body *ProjectInput) (*ProjectResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/projects/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	projectResponse := new(ProjectResponse)
+
+	err = op.client.Do(ctx, req, projectResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectResponse, nil
+}
+
+/*CreateRole Creates a Role
+ * This operation submits a request to create a Role based on the input parameters.
+ *
+ * @param request
+ * @return *RoleResponse
+ */
+func (op Operations) CreateRole(request *RoleInput) (*RoleResponse, error) {
+	ctx := context.TODO()
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, "/roles", request)
+	if err != nil {
+		return nil, err
+	}
+
+	roleResponse := new(RoleResponse)
+
+	err = op.client.Do(ctx, req, roleResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return roleResponse, nil
+}
+
+/*DeleteRole Deletes a Role
+ * This operation submits a request to delete a Role.
+ *
+ * @param UUID The UUID of the entity.
+ * @return void
+ */
+func (op Operations) DeleteRole(UUID string) error {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/roles/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+
+	return op.client.Do(ctx, req, nil)
+}
+
+/*GetRole Gets a Role
+ * This operation gets a Role.
+ *
+ * @param UUID The UUID of the entity.
+ * @return *RoleResponse
+ */
+func (op Operations) GetRole(UUID string) (*RoleResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/roles/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	roleResponse := new(RoleResponse)
+
+	err = op.client.Do(ctx, req, roleResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return roleResponse, nil
+}
+
+/*ListRole Gets all roles
+ * This operation gets a list of roles, allowing for sorting and pagination. Note: Entities that have not been created successfully are not listed.
+ *
+ * @param getEntitiesRequest
+ * @return *RoleListResponse
+ */
+func (op Operations) ListRole(getEntitiesRequest *ListMetadata) (*RoleListResponse, error) {
+	ctx := context.TODO()
+	path := "/roles/list"
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, path, getEntitiesRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	roleListResponse := new(RoleListResponse)
+
+	err = op.client.Do(ctx, req, roleListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return roleListResponse, nil
+}
+
+/*UpdateRole Updates a Role
+ * This operation submits a request to update a Role based on the input parameters.
+ *
+ * @param uuid The UUID of the entity.
+ * @param body
+ * @return *RoleResponse
+ */
+func (op Operations) UpdateRole(UUID string, body *RoleInput) (*RoleResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/roles/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	roleResponse := new(RoleResponse)
+
+	err = op.client.Do(ctx, req, roleResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return roleResponse, nil
+}
+
+/*GetPermission Gets a Permission
+ * This operation gets a Permission.
+ *
+ * @param UUID The UUID of the entity.
+ * @return *PermissionResponse
+ */
+func (op Operations) GetPermission(UUID string) (*PermissionResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/permissions/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	permissionResponse := new(PermissionResponse)
+
+	err = op.client.Do(ctx, req, permissionResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return permissionResponse, nil
+}
+
+/*ListPermission Gets all permissions
+ * This operation gets a list of permissions, allowing for sorting and pagination. Note: Entities that have not been created successfully are not listed.
+ *
+ * @param getEntitiesRequest
+ * @return *PermissionListResponse
+ */
+func (op Operations) ListPermission(getEntitiesRequest *ListMetadata) (*PermissionListResponse, error) {
+	ctx := context.TODO()
+	path := "/permissions/list"
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, path, getEntitiesRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	permissionListResponse := new(PermissionListResponse)
+
+	err = op.client.Do(ctx, req, permissionListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return permissionListResponse, nil
+}
+
+/*CreateAccessControlPolicy Creates an Access control policy
+ * This operation submits a request to create an Access control policy based on the input parameters.
+ *
+ * @param request
+ * @return *AccessControlPolicyResponse
+ */
+func (op Operations) CreateAccessControlPolicy(request *AccessControlPolicyInput) (*AccessControlPolicyResponse, error) {
+	ctx := context.TODO()
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, "/access_control_policies", request)
+	if err != nil {
+		return nil, err
+	}
+
+	accessControlPolicyResponse := new(AccessControlPolicyResponse)
+
+	err = op.client.Do(ctx, req, accessControlPolicyResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return accessControlPolicyResponse, nil
+}
+
+/*DeleteAccessControlPolicy Deletes an Access control policy
+ * This operation submits a request to delete an Access control policy.
+ *
+ * @param UUID The UUID of the entity.
+ * @return void
+ */
+func (op Operations) DeleteAccessControlPolicy(UUID string) error {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/access_control_policies/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+
+	return op.client.Do(ctx, req, nil)
+}
+
+/*GetAccessControlPolicy Gets an Access control policy
+ * This operation gets an Access control policy.
+ *
+ * @param UUID The UUID of the entity.
+ * @return *AccessControlPolicyResponse
+ */
+func (op Operations) GetAccessControlPolicy(UUID string) (*AccessControlPolicyResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/access_control_policies/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	accessControlPolicyResponse := new(AccessControlPolicyResponse)
+
+	err = op.client.Do(ctx, req, accessControlPolicyResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return accessControlPolicyResponse, nil
+}
+
+/*ListAccessControlPolicy Gets all access control policies
+ * This operation gets a list of access control policies, allowing for sorting and pagination. Note: Entities that have not been created successfully are not listed.
+ *
+ * @param getEntitiesRequest
+ * @return *AccessControlPolicyListResponse
+ */
+func (op Operations) ListAccessControlPolicy(getEntitiesRequest *ListMetadata) (*AccessControlPolicyListResponse, error) {
+	ctx := context.TODO()
+	path := "/access_control_policies/list"
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, path, getEntitiesRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	accessControlPolicyListResponse := new(AccessControlPolicyListResponse)
+
+	err = op.client.Do(ctx, req, accessControlPolicyListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return accessControlPolicyListResponse, nil
+}
+
+/*UpdateAccessControlPolicy Updates an Access control policy
+ * This operation submits a request to update an Access control policy based on the input parameters.
+ *
+ * @param uuid The UUID of the entity.
+ * @param body
+ * @return *AccessControlPolicyResponse
+ */
+func (op Operations) UpdateAccessControlPolicy(UUID string, body *AccessControlPolicyInput) (*AccessControlPolicyResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/access_control_policies/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	accessControlPolicyResponse := new(AccessControlPolicyResponse)
+
+	err = op.client.Do(ctx, req, accessControlPolicyResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return accessControlPolicyResponse, nil
+}
+
+/*CreateUser Creates an User
+ * This operation submits a request to create an User based on the input parameters.
+ *
+ * @param request
+ * @return *UserResponse
+ */
+func (op Operations) CreateUser(request *UserInput) (*UserResponse, error) {
+	ctx := context.TODO()
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, "/users", request)
+	if err != nil {
+		return nil, err
+	}
+
+	userResponse := new(UserResponse)
+
+	err = op.client.Do(ctx, req, userResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return userResponse, nil
+}
+
+/*DeleteUser Deletes an User
+ * This operation submits a request to delete an User.
+ *
+ * @param UUID The UUID of the entity.
+ * @return void
+ */
+func (op Operations) DeleteUser(UUID string) error {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/users/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+
+	return op.client.Do(ctx, req, nil)
+}
+
+/*GetUser Gets an User
+ * This operation gets an User.
+ *
+ * @param UUID The UUID of the entity.
+ * @return *UserResponse
+ */
+func (op Operations) GetUser(UUID string) (*UserResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/users/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	userResponse := new(UserResponse)
+
+	err = op.client.Do(ctx, req, userResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return userResponse, nil
+}
+
+/*ListUser Gets all users
+ * This operation gets a list of users, allowing for sorting and pagination. Note: Entities that have not been created successfully are not listed.
+ *
+ * @param getEntitiesRequest
+ * @return *UserListResponse
+ */
+func (op Operations) ListUser(getEntitiesRequest *ListMetadata) (*UserListResponse, error) {
+	ctx := context.TODO()
+	path := "/users/list"
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, path, getEntitiesRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	userListResponse := new(UserListResponse)
+
+	err = op.client.Do(ctx, req, userListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return userListResponse, nil
+}
+
+/*UpdateUser Updates an User
+ * This operation submits a request to update an User based on the input parameters.
+ *
+ * @param uuid The UUID of the entity.
+ * @param body
+ * @return *UserResponse
+ */
+func (op Operations) UpdateUser(UUID string, body *UserInput) (*UserResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/users/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodPut, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	userResponse := new(UserResponse)
+
+	err = op.client.Do(ctx, req, userResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return userResponse, nil
+}
+
+/*CreateUserGroup Creates an User group
+ * This operation submits a request to create an User group based on the input parameters.
+ *
+ * @param request
+ * @return *UserGroupResponse
+ */
+func (op Operations) CreateUserGroup(request *UserGroupInput) (*UserGroupResponse, error) {
+	ctx := context.TODO()
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, "/user_groups", request)
+	if err != nil {
+		return nil, err
+	}
+
+	userGroupResponse := new(UserGroupResponse)
+
+	err = op.client.Do(ctx, req, userGroupResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return userGroupResponse, nil
+}
+
+/*DeleteUserGroup Deletes an User group
+ * This operation submits a request to delete an User group.
+ *
+ * @param UUID The UUID of the entity.
+ * @return void
+ */
+func (op Operations) DeleteUserGroup(UUID string) error {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/user_groups/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+
+	return op.client.Do(ctx, req, nil)
+}
+
+/*GetUserGroup Gets an User group
+ * This operation gets an User group.
+ *
+ * @param UUID The UUID of the entity.
+ * @return *UserGroupResponse
+ */
+func (op Operations) GetUserGroup(UUID string) (*UserGroupResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/user_groups/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	userGroupResponse := new(UserGroupResponse)
+
+	err = op.client.Do(ctx, req, userGroupResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return userGroupResponse, nil
+}
+
+/*ListUserGroup Gets all user groups
+ * This operation gets a list of user groups, allowing for sorting and pagination. Note: Entities that have not been created successfully are not listed.
+ *
+ * @param getEntitiesRequest
+ * @return *UserGroupListResponse
+ */
+func (op Operations) ListUserGroup(getEntitiesRequest *ListMetadata) (*UserGroupListResponse, error) {
+	ctx := context.TODO()
+	path := "/user_groups/list"
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, path, getEntitiesRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	userGroupListResponse := new(UserGroupListResponse)
+
+	err = op.client.Do(ctx, req, userGroupListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return userGroupListResponse, nil
+}
+
+/*GetDirectoryService Gets a Directory service
+ * This operation gets a Directory service.
+ *
+ * @param UUID The UUID of the entity.
+ * @return *DirectoryServiceResponse
+ */
+func (op Operations) GetDirectoryService(UUID string) (*DirectoryServiceResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/directory_services/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	directoryServiceResponse := new(DirectoryServiceResponse)
+
+	err = op.client.Do(ctx, req, directoryServiceResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return directoryServiceResponse, nil
+}
+
+/*ListDirectoryService Gets all directory services
+ * This operation gets a list of directory services, allowing for sorting and pagination. Note: Entities that have not been created successfully are not listed.
+ *
+ * @param getEntitiesRequest
+ * @return *DirectoryServiceListResponse
+ */
+func (op Operations) ListDirectoryService(getEntitiesRequest *ListMetadata) (*DirectoryServiceListResponse, error) {
+	ctx := context.TODO()
+	path := "/directory_services/list"
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, path, getEntitiesRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	directoryServiceListResponse := new(DirectoryServiceListResponse)
+
+	err = op.client.Do(ctx, req, directoryServiceListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return directoryServiceListResponse, nil
+}
+
+/*GetHost Gets a host
+ * This operation gets a host.
+ *
+ * @param UUID The UUID of the entity.
+ * @return *HostResponse
+ */
+func (op Operations) GetHost(UUID string) (*HostResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/hosts/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hostResponse := new(HostResponse)
+
+	err = op.client.Do(ctx, req, hostResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return hostResponse, nil
+}
+
+/*ListHost Gets all hosts
+ * This operation gets a list of hosts, allowing for sorting and pagination. Note: Entities that have not been created successfully are not listed.
+ *
+ * @param getEntitiesRequest
+ * @return *HostListResponse
+ */
+func (op Operations) ListHost(getEntitiesRequest *ListMetadata) (*HostListResponse, error) {
+	ctx := context.TODO()
+	path := "/hosts/list"
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, path, getEntitiesRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	hostListResponse := new(HostListResponse)
+
+	err = op.client.Do(ctx, req, hostListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return hostListResponse, nil
+}
+
+/*CloneVM Clones a VM
+ * This operation submits a request to clone a VM, overriding the values given in the override spec.
+ *
+ * @param uuid The UUID of the VM to clone.
+ * @param body
+ * @return *TaskReference
+ */
+func (op Operations) CloneVM(UUID string, body *VMCloneInput) (*TaskReference, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/vms/%s/clone", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	taskReference := new(TaskReference)
+
+	err = op.client.Do(ctx, req, taskReference)
+	if err != nil {
+		return nil, err
+	}
+
+	return taskReference, nil
+}
+
+/*GetTask Gets a task
+ * This operation gets the status of an asynchronous task.
+ *
+ * @param uuid The UUID of the task.
+ * @return *Task
+ */
+func (op Operations) GetTask(UUID string) (*Task, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/tasks/%s", UUID)
+
+	req, err := op.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	task := new(Task)
+
+	err = op.client.Do(ctx, req, task)
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
@@ -110,6 +110,9 @@ type VMBootConfig struct {
 
 	// Indicates the order of device types in which VM should try to boot from. If boot device order is not provided the system will decide appropriate boot device order.
 	BootDeviceOrderList []*string `json:"boot_device_order_list,omitempty"`
+
+	// Firmware the VM boots with (LEGACY/UEFI/SECURE_BOOT). Secure boot is UEFI with secure boot enabled and needs the Q35 machine type.
+	BootType *string `json:"boot_type,omitempty"`
 }
 
 //NutanixGuestToolsSpec Information regarding Nutanix Guest Tools.
@@ -247,6 +250,9 @@ type VMResources struct {
 	// VM's hardware clock timezone in IANA TZDB format (America/Los_Angeles).
 	HardwareClockTimezone *string `json:"hardware_clock_timezone,omitempty"`
 
+	// Machine type of the VM (PC/PSERIES/Q35).
+	MachineType *string `json:"machine_type,omitempty"`
+
 	// Memory size in MiB.
 	MemorySizeMib *int64 `json:"memory_size_mib,omitempty"`
 
@@ -465,6 +471,9 @@ type VMResourcesDefStatus struct {
 	// The hypervisor type for the hypervisor the VM is hosted on.
 	HypervisorType *string `json:"hypervisor_type,omitempty"`
 
+	// Machine type of the VM (PC/PSERIES/Q35).
+	MachineType *string `json:"machine_type,omitempty"`
+
 	// Memory size in MiB.
 	MemorySizeMib *int64 `json:"memory_size_mib,omitempty"`
 
@@ -1861,3 +1870,854 @@ type NetworkSecurityRuleListIntentResponse struct {
 
 	Metadata ListMetadataOutput `json:"metadata"`
 }
+
+//VMAttachment Attachment of a volume group to a VM or to an external iSCSI initiator.
+type VMAttachment struct {
+
+	// Name of the iSCSI initiator of the client that is allowed to connect to the volume group.
+	IscsiInitiatorName *string `json:"iscsi_initiator_name,omitempty"`
+
+	VMReference *Reference `json:"vm_reference,omitempty"`
+}
+
+//VGDisk A disk of a volume group.
+type VGDisk struct {
+	DataSourceReference *Reference `json:"data_source_reference,omitempty"`
+
+	// Size of the disk in MiB.
+	DiskSizeMib *int64 `json:"disk_size_mib,omitempty"`
+
+	// Index of the disk in the volume group.
+	Index *int64 `json:"index,omitempty"`
+
+	// UUID of the storage container the disk is placed in.
+	StorageContainerUUID *string `json:"storage_container_uuid,omitempty"`
+
+	// UUID of the backing vmdisk.
+	VmdiskUUID *string `json:"vmdisk_uuid,omitempty"`
+}
+
+//VolumeGroupResources Volume group resources.
+type VolumeGroupResources struct {
+
+	// VMs and iSCSI initiators the volume group is attached to.
+	AttachmentList []*VMAttachment `json:"attachment_list,omitempty"`
+
+	// Disks of the volume group.
+	DiskList []*VGDisk `json:"disk_list,omitempty"`
+
+	// File system type of the volume group.
+	FileSystemType *string `json:"file_system_type,omitempty"`
+
+	// Flash mode of the volume group.
+	FlashMode *string `json:"flash_mode,omitempty"`
+
+	// Prefix of the iSCSI target name of the volume group.
+	IscsiTargetPrefix *string `json:"iscsi_target_prefix,omitempty"`
+
+	// Whether the volume group can be attached to multiple VMs at the same time.
+	SharingStatus *string `json:"sharing_status,omitempty"`
+}
+
+//VolumeGroup Volume group definition.
+type VolumeGroup struct {
+	Description *string `json:"description,omitempty"`
+
+	Name *string `json:"name"`
+
+	Resources *VolumeGroupResources `json:"resources,omitempty"`
+}
+
+//VolumeGroupInput An intentful representation of a volume_group
+type VolumeGroupInput struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *VolumeGroup `json:"spec"`
+}
+
+//VolumeGroupResourcesStatus Volume group resources as reported by the cluster.
+type VolumeGroupResourcesStatus struct {
+	VolumeGroupResources
+
+	// Full iSCSI target name of the volume group.
+	IscsiTargetName *string `json:"iscsi_target_name,omitempty"`
+
+	// Total size of the volume group in bytes.
+	SizeBytes *int64 `json:"size_bytes,omitempty"`
+
+	// Total size of the volume group in MiB.
+	SizeMib *int64 `json:"size_mib,omitempty"`
+}
+
+//VolumeGroupDefStatus Volume group status
+type VolumeGroupDefStatus struct {
+	Description *string `json:"description,omitempty"`
+
+	MessageList []*MessageResource `json:"message_list,omitempty"`
+
+	Name *string `json:"name,omitempty"`
+
+	Resources *VolumeGroupResourcesStatus `json:"resources,omitempty"`
+
+	// The state of the volume group.
+	State *string `json:"state,omitempty"`
+}
+
+//VolumeGroupResponse Response object for intentful operations on a volume_group
+type VolumeGroupResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *VolumeGroup `json:"spec,omitempty"`
+
+	Status *VolumeGroupDefStatus `json:"status,omitempty"`
+}
+
+//VolumeGroupListResponse Response object for intentful operation of volume_groups
+type VolumeGroupListResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Entities []*VolumeGroupResponse `json:"entities,omitempty"`
+
+	Metadata *ListMetadataOutput `json:"metadata"`
+}
+
+//ResourceDomainResource A quota of a single resource type of a project.
+type ResourceDomainResource struct {
+
+	// The resource consumption limit.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// The type of resource (VCPUS, MEMORY or STORAGE).
+	ResourceType *string `json:"resource_type"`
+
+	// The units of the resource.
+	Units *string `json:"units,omitempty"`
+
+	// The amount of the resource consumed.
+	Value *int64 `json:"value,omitempty"`
+}
+
+//ResourceDomain The resource domain of a project, holding its quotas.
+type ResourceDomain struct {
+	Resources []*ResourceDomainResource `json:"resources,omitempty"`
+}
+
+//ProjectResources Project resources.
+type ProjectResources struct {
+
+	// Accounts that the project can use.
+	AccountReferenceList []*Reference `json:"account_reference_list,omitempty"`
+
+	// The default subnet of VMs deployed in the project.
+	DefaultSubnetReference *Reference `json:"default_subnet_reference,omitempty"`
+
+	// Environments associated with the project.
+	EnvironmentReferenceList []*Reference `json:"environment_reference_list,omitempty"`
+
+	// User groups that are members of the project.
+	ExternalUserGroupReferenceList []*Reference `json:"external_user_group_reference_list,omitempty"`
+
+	ResourceDomain *ResourceDomain `json:"resource_domain,omitempty"`
+
+	// Subnets that VMs in the project can be attached to.
+	SubnetReferenceList []*Reference `json:"subnet_reference_list,omitempty"`
+
+	// Users that are members of the project.
+	UserReferenceList []*Reference `json:"user_reference_list,omitempty"`
+}
+
+//Project Project definition.
+type Project struct {
+	Description *string `json:"description,omitempty"`
+
+	Name *string `json:"name"`
+
+	Resources *ProjectResources `json:"resources"`
+}
+
+//ProjectInput An intentful representation of a project
+type ProjectInput struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *Project `json:"spec"`
+}
+
+//ProjectStatus Project status
+type ProjectStatus struct {
+	Description *string `json:"description,omitempty"`
+
+	MessageList []*MessageResource `json:"message_list,omitempty"`
+
+	Name *string `json:"name,omitempty"`
+
+	Resources *ProjectResources `json:"resources,omitempty"`
+
+	// The state of the project.
+	State *string `json:"state,omitempty"`
+}
+
+//ProjectResponse Response object for intentful operations on a project
+type ProjectResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *Project `json:"spec,omitempty"`
+
+	Status *ProjectStatus `json:"status,omitempty"`
+}
+
+//ProjectListResponse Response object for intentful operation of projects
+type ProjectListResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Entities []*ProjectResponse `json:"entities,omitempty"`
+
+	Metadata *ListMetadataOutput `json:"metadata"`
+}
+
+//RoleResources Role resources.
+type RoleResources struct {
+
+	// Permissions granted by the role.
+	PermissionReferenceList []*Reference `json:"permission_reference_list,omitempty"`
+}
+
+//Role Role definition.
+type Role struct {
+	Description *string `json:"description,omitempty"`
+
+	Name *string `json:"name"`
+
+	Resources *RoleResources `json:"resources"`
+}
+
+//RoleInput An intentful representation of a role
+type RoleInput struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *Role `json:"spec"`
+}
+
+//RoleStatus Role status
+type RoleStatus struct {
+	Description *string `json:"description,omitempty"`
+
+	// Whether the role is built in and cannot be modified.
+	IsSystemDefined *bool `json:"is_system_defined,omitempty"`
+
+	MessageList []*MessageResource `json:"message_list,omitempty"`
+
+	Name *string `json:"name,omitempty"`
+
+	Resources *RoleResources `json:"resources,omitempty"`
+
+	// The state of the role.
+	State *string `json:"state,omitempty"`
+}
+
+//RoleResponse Response object for intentful operations on a role
+type RoleResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *Role `json:"spec,omitempty"`
+
+	Status *RoleStatus `json:"status,omitempty"`
+}
+
+//RoleListResponse Response object for intentful operation of roles
+type RoleListResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Entities []*RoleResponse `json:"entities,omitempty"`
+
+	Metadata *ListMetadataOutput `json:"metadata"`
+}
+
+//PermissionResources Permission resources.
+type PermissionResources struct {
+
+	// The kind of entity the permission applies to.
+	Kind *string `json:"kind,omitempty"`
+
+	// The operation the permission allows.
+	Operation *string `json:"operation,omitempty"`
+}
+
+//Permission Permission definition.
+type Permission struct {
+	Description *string `json:"description,omitempty"`
+
+	Name *string `json:"name,omitempty"`
+
+	Resources *PermissionResources `json:"resources,omitempty"`
+}
+
+//PermissionResponse Response object for operations on a permission
+type PermissionResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *Permission `json:"spec,omitempty"`
+
+	Status *Permission `json:"status,omitempty"`
+}
+
+//PermissionListResponse Response object for list of permissions
+type PermissionListResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Entities []*PermissionResponse `json:"entities,omitempty"`
+
+	Metadata *ListMetadataOutput `json:"metadata"`
+}
+
+//RightHandSide The right hand side of a filter expression.
+type RightHandSide struct {
+
+	// A collection of entities, ALL or SELF_OWNED.
+	Collection *string `json:"collection,omitempty"`
+
+	// Categories the entities must have.
+	Categories map[string][]string `json:"categories,omitempty"`
+
+	// UUIDs of the entities.
+	UUIDList []string `json:"uuid_list,omitempty"`
+}
+
+//LeftHandSide The left hand side of an entity filter expression.
+type LeftHandSide struct {
+
+	// The kind of entity the filter applies to.
+	EntityType *string `json:"entity_type,omitempty"`
+}
+
+//ScopeFilterExpression A filter on the scope of an access control policy.
+type ScopeFilterExpression struct {
+
+	// The attribute the scope is matched on (PROJECT, CLUSTER or CATEGORY).
+	LeftHandSide *string `json:"left_hand_side"`
+
+	// The operator of the filter expression.
+	Operator *string `json:"operator"`
+
+	RightHandSide *RightHandSide `json:"right_hand_side"`
+}
+
+//EntityFilterExpression A filter on the entities an access control policy applies to.
+type EntityFilterExpression struct {
+	LeftHandSide *LeftHandSide `json:"left_hand_side"`
+
+	// The operator of the filter expression.
+	Operator *string `json:"operator"`
+
+	RightHandSide *RightHandSide `json:"right_hand_side"`
+}
+
+//ContextList A context of an access control policy: the entities in a scope.
+type ContextList struct {
+	EntityFilterExpressionList []*EntityFilterExpression `json:"entity_filter_expression_list"`
+
+	ScopeFilterExpressionList []*ScopeFilterExpression `json:"scope_filter_expression_list,omitempty"`
+}
+
+//FilterList The entities an access control policy applies to.
+type FilterList struct {
+	ContextList []*ContextList `json:"context_list,omitempty"`
+}
+
+//AccessControlPolicyResources Access control policy resources.
+type AccessControlPolicyResources struct {
+	FilterList *FilterList `json:"filter_list,omitempty"`
+
+	RoleReference *Reference `json:"role_reference"`
+
+	// User groups the policy applies to.
+	UserGroupReferenceList []*Reference `json:"user_group_reference_list,omitempty"`
+
+	// Users the policy applies to.
+	UserReferenceList []*Reference `json:"user_reference_list,omitempty"`
+}
+
+//AccessControlPolicy Access control policy definition.
+type AccessControlPolicy struct {
+	Description *string `json:"description,omitempty"`
+
+	Name *string `json:"name"`
+
+	Resources *AccessControlPolicyResources `json:"resources"`
+}
+
+//AccessControlPolicyInput An intentful representation of an access_control_policy
+type AccessControlPolicyInput struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *AccessControlPolicy `json:"spec"`
+}
+
+//AccessControlPolicyStatus Access control policy status
+type AccessControlPolicyStatus struct {
+	Description *string `json:"description,omitempty"`
+
+	MessageList []*MessageResource `json:"message_list,omitempty"`
+
+	Name *string `json:"name,omitempty"`
+
+	Resources *AccessControlPolicyResources `json:"resources,omitempty"`
+
+	// The state of the access control policy.
+	State *string `json:"state,omitempty"`
+}
+
+//AccessControlPolicyResponse Response object for intentful operations on an access_control_policy
+type AccessControlPolicyResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *AccessControlPolicy `json:"spec,omitempty"`
+
+	Status *AccessControlPolicyStatus `json:"status,omitempty"`
+}
+
+//AccessControlPolicyListResponse Response object for intentful operation of access_control_policies
+type AccessControlPolicyListResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Entities []*AccessControlPolicyResponse `json:"entities,omitempty"`
+
+	Metadata *ListMetadataOutput `json:"metadata"`
+}
+
+//DirectoryServiceUser A user of a directory service.
+type DirectoryServiceUser struct {
+	DirectoryServiceReference *Reference `json:"directory_service_reference,omitempty"`
+
+	// The user principal name of the user in the directory service.
+	UserPrincipalName *string `json:"user_principal_name,omitempty"`
+}
+
+//UserResources User resources.
+type UserResources struct {
+
+	// Access control policies that apply to the user.
+	AccessControlPolicyReferenceList []*Reference `json:"access_control_policy_reference_list,omitempty"`
+
+	DirectoryServiceUser *DirectoryServiceUser `json:"directory_service_user,omitempty"`
+
+	// The display name of the user.
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// Projects the user is a member of.
+	ProjectsReferenceList []*Reference `json:"projects_reference_list,omitempty"`
+
+	// The type of the user.
+	UserType *string `json:"user_type,omitempty"`
+}
+
+//User User definition.
+type User struct {
+	Resources *UserResources `json:"resources"`
+}
+
+//UserInput An intentful representation of a user
+type UserInput struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *User `json:"spec"`
+}
+
+//UserStatus User status
+type UserStatus struct {
+	MessageList []*MessageResource `json:"message_list,omitempty"`
+
+	Name *string `json:"name,omitempty"`
+
+	Resources *UserResources `json:"resources,omitempty"`
+
+	// The state of the user.
+	State *string `json:"state,omitempty"`
+}
+
+//UserResponse Response object for intentful operations on a user
+type UserResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *User `json:"spec,omitempty"`
+
+	Status *UserStatus `json:"status,omitempty"`
+}
+
+//UserListResponse Response object for intentful operation of users
+type UserListResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Entities []*UserResponse `json:"entities,omitempty"`
+
+	Metadata *ListMetadataOutput `json:"metadata"`
+}
+
+//DirectoryServiceUserGroup A group of a directory service.
+type DirectoryServiceUserGroup struct {
+	DirectoryServiceReference *Reference `json:"directory_service_reference,omitempty"`
+
+	// The distinguished name of the group in the directory service.
+	DistinguishedName *string `json:"distinguished_name,omitempty"`
+}
+
+//UserGroupResources User group resources.
+type UserGroupResources struct {
+
+	// Access control policies that apply to the group.
+	AccessControlPolicyReferenceList []*Reference `json:"access_control_policy_reference_list,omitempty"`
+
+	DirectoryServiceUserGroup *DirectoryServiceUserGroup `json:"directory_service_user_group,omitempty"`
+
+	// The display name of the group.
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// Projects the group is a member of.
+	ProjectsReferenceList []*Reference `json:"projects_reference_list,omitempty"`
+}
+
+//UserGroup User group definition.
+type UserGroup struct {
+	Resources *UserGroupResources `json:"resources"`
+}
+
+//UserGroupInput An intentful representation of a user_group
+type UserGroupInput struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *UserGroup `json:"spec"`
+}
+
+//UserGroupStatus User group status
+type UserGroupStatus struct {
+	MessageList []*MessageResource `json:"message_list,omitempty"`
+
+	Resources *UserGroupResources `json:"resources,omitempty"`
+
+	// The state of the user group.
+	State *string `json:"state,omitempty"`
+}
+
+//UserGroupResponse Response object for intentful operations on a user_group
+type UserGroupResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *UserGroup `json:"spec,omitempty"`
+
+	Status *UserGroupStatus `json:"status,omitempty"`
+}
+
+//UserGroupListResponse Response object for intentful operation of user_groups
+type UserGroupListResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Entities []*UserGroupResponse `json:"entities,omitempty"`
+
+	Metadata *ListMetadataOutput `json:"metadata"`
+}
+
+//DirectoryServiceResources Directory service resources.
+type DirectoryServiceResources struct {
+
+	// The type of directory service, e.g. ACTIVE_DIRECTORY.
+	DirectoryType *string `json:"directory_type,omitempty"`
+
+	// The domain name of the directory service.
+	DomainName *string `json:"domain_name,omitempty"`
+
+	// The URL of the directory service.
+	URL *string `json:"url,omitempty"`
+}
+
+//DirectoryService Directory service definition.
+type DirectoryService struct {
+	Name *string `json:"name,omitempty"`
+
+	Resources *DirectoryServiceResources `json:"resources,omitempty"`
+}
+
+//DirectoryServiceResponse Response object for operations on a directory_service
+type DirectoryServiceResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *DirectoryService `json:"spec,omitempty"`
+
+	Status *DirectoryService `json:"status,omitempty"`
+}
+
+//DirectoryServiceListResponse Response object for list of directory_services
+type DirectoryServiceListResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Entities []*DirectoryServiceResponse `json:"entities,omitempty"`
+
+	Metadata *ListMetadataOutput `json:"metadata"`
+}
+
+//HostGpu A physical GPU on a host.
+type HostGpu struct {
+
+	// Whether the GPU can be assigned to a VM.
+	Assignable *bool `json:"assignable,omitempty"`
+
+	// The VM the GPU is assigned to, if any.
+	ConsumerReference *Reference `json:"consumer_reference,omitempty"`
+
+	// The device ID of the GPU.
+	DeviceID *int64 `json:"device_id,omitempty"`
+
+	// Fraction of the physical GPU a vGPU of this profile takes.
+	Fraction *int64 `json:"fraction,omitempty"`
+
+	// GPU frame buffer size in MiB.
+	FrameBufferSizeMib *int64 `json:"frame_buffer_size_mib,omitempty"`
+
+	// Index of the GPU on the host.
+	Index *int64 `json:"index,omitempty"`
+
+	// Maximum resolution of the GPU.
+	MaxResolution *string `json:"max_resolution,omitempty"`
+
+	// The mode of the GPU: PASSTHROUGH_GRAPHICS, PASSTHROUGH_COMPUTE or VIRTUAL.
+	Mode *string `json:"mode,omitempty"`
+
+	// The model or vGPU profile name of the GPU.
+	Name *string `json:"name,omitempty"`
+
+	// Number of vGPUs allocated on the GPU.
+	NumVgpusAllocated *int64 `json:"num_vgpus_allocated,omitempty"`
+
+	// NUMA node the GPU is attached to.
+	NumaNode *int64 `json:"numa_node,omitempty"`
+
+	// GPU {segment:bus:device:function} (sbdf) address.
+	PCIAddress *string `json:"pci_address,omitempty"`
+
+	// Status of the GPU.
+	Status *string `json:"status,omitempty"`
+
+	// UUID of the GPU.
+	UUID *string `json:"uuid,omitempty"`
+
+	// The vendor of the GPU: NVIDIA, INTEL or AMD.
+	Vendor *string `json:"vendor,omitempty"`
+}
+
+//HostHypervisor The hypervisor running on a host.
+type HostHypervisor struct {
+
+	// Full name of the hypervisor, including its version.
+	HypervisorFullName *string `json:"hypervisor_full_name,omitempty"`
+
+	// IP address of the hypervisor.
+	IP *string `json:"ip,omitempty"`
+
+	// Number of VMs running on the hypervisor.
+	NumVms *int64 `json:"num_vms,omitempty"`
+}
+
+//HostControllerVM The controller VM (CVM) of a host.
+type HostControllerVM struct {
+
+	// IP address of the controller VM.
+	IP *string `json:"ip,omitempty"`
+}
+
+//HostIPMI The IPMI interface of a host.
+type HostIPMI struct {
+
+	// IP address of the IPMI interface.
+	IP *string `json:"ip,omitempty"`
+}
+
+//HostBlock The block a host is installed in.
+type HostBlock struct {
+	BlockModel *string `json:"block_model,omitempty"`
+
+	BlockSerialNumber *string `json:"block_serial_number,omitempty"`
+}
+
+//HostResources Host resources.
+type HostResources struct {
+	Block *HostBlock `json:"block,omitempty"`
+
+	ControllerVM *HostControllerVM `json:"controller_vm,omitempty"`
+
+	// CPU capacity of the host in Hz.
+	CPUCapacityHz *int64 `json:"cpu_capacity_hz,omitempty"`
+
+	// CPU model of the host.
+	CPUModel *string `json:"cpu_model,omitempty"`
+
+	// Version of the GPU driver installed on the host.
+	GpuDriverVersion *string `json:"gpu_driver_version,omitempty"`
+
+	// GPUs installed on the host.
+	GpuList []*HostGpu `json:"gpu_list,omitempty"`
+
+	// The type of the host, e.g. HYPER_CONVERGED.
+	HostType *string `json:"host_type,omitempty"`
+
+	Hypervisor *HostHypervisor `json:"hypervisor,omitempty"`
+
+	IPMI *HostIPMI `json:"ipmi,omitempty"`
+
+	// Memory capacity of the host in MiB.
+	MemoryCapacityMib *int64 `json:"memory_capacity_mib,omitempty"`
+
+	// Number of CPU cores on the host.
+	NumCPUCores *int64 `json:"num_cpu_cores,omitempty"`
+
+	// Number of CPU sockets on the host.
+	NumCPUSockets *int64 `json:"num_cpu_sockets,omitempty"`
+
+	// Serial number of the host.
+	SerialNumber *string `json:"serial_number,omitempty"`
+}
+
+//Host Host definition.
+type Host struct {
+	Name *string `json:"name,omitempty"`
+
+	Resources *HostResources `json:"resources,omitempty"`
+}
+
+//HostStatus Host status.
+type HostStatus struct {
+
+	// The cluster the host belongs to.
+	ClusterReference *Reference `json:"cluster_reference,omitempty"`
+
+	MessageList []*MessageResource `json:"message_list,omitempty"`
+
+	Name *string `json:"name,omitempty"`
+
+	Resources *HostResources `json:"resources,omitempty"`
+
+	// The state of the host.
+	State *string `json:"state,omitempty"`
+}
+
+//HostResponse Response object for operations on a host
+type HostResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *Metadata `json:"metadata"`
+
+	Spec *Host `json:"spec,omitempty"`
+
+	Status *HostStatus `json:"status,omitempty"`
+}
+
+//HostListResponse Response object for list of hosts
+type HostListResponse struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Entities []*HostResponse `json:"entities,omitempty"`
+
+	Metadata *ListMetadataOutput `json:"metadata"`
+}
+
+//ClusterIntentInput An intentful representation of a cluster
+type ClusterIntentInput struct {
+	APIVersion *string `json:"api_version,omitempty"`
+
+	Metadata *ClusterMetadata `json:"metadata"`
+
+	Spec *Cluster `json:"spec"`
+}
+
+//VMCloneOverrideSpec Values of the source VM to change in the clone.
+type VMCloneOverrideSpec struct {
+	BootConfig *VMBootConfig `json:"boot_config,omitempty"`
+
+	GuestCustomization *GuestCustomization `json:"guest_customization,omitempty"`
+
+	// Memory size in MiB.
+	MemorySizeMib *int64 `json:"memory_size_mib,omitempty"`
+
+	// Name of the clone.
+	Name *string `json:"name,omitempty"`
+
+	// NICs of the clone, replacing the NICs of the source VM.
+	NicList []*VMNic `json:"nic_list,omitempty"`
+
+	// Number of vCPU sockets.
+	NumSockets *int64 `json:"num_sockets,omitempty"`
+
+	// Number of vCPUs per socket.
+	NumVcpusPerSocket *int64 `json:"num_vcpus_per_socket,omitempty"`
+}
+
+//VMCloneMetadata Metadata of the clone.
+type VMCloneMetadata struct {
+	// UUID to give the clone, generated by the server when left out.
+	UUID *string `json:"uuid,omitempty"`
+}
+
+//VMCloneInput Input for cloning a VM.
+type VMCloneInput struct {
+	Metadata *VMCloneMetadata `json:"metadata,omitempty"`
+
+	OverrideSpec *VMCloneOverrideSpec `json:"override_spec,omitempty"`
+}
+
+//TaskReference Response of actions that run asynchronously as a task.
+type TaskReference struct {
+	TaskUUID *string `json:"task_uuid,omitempty"`
+}
+
+//Task Status of an asynchronous task.
+type Task struct {
+	// Time the task completed, in microseconds since the epoch.
+	CompletionTime *string `json:"completion_time,omitempty"`
+
+	// Time the task was created.
+	CreationTime *string `json:"creation_time,omitempty"`
+
+	// Entities the task operates on, e.g. the VM created by a clone.
+	EntityReferenceList []*Reference `json:"entity_reference_list,omitempty"`
+
+	ErrorCode *string `json:"error_code,omitempty"`
+
+	ErrorDetail *string `json:"error_detail,omitempty"`
+
+	// Operation the task performs, e.g. kVmClone.
+	OperationType *string `json:"operation_type,omitempty"`
+
+	PercentageComplete *int64 `json:"percentage_complete,omitempty"`
+
+	ProgressMessage *string `json:"progress_message,omitempty"`
+
+	// The state of the task: QUEUED, RUNNING, SUCCEEDED, FAILED or ABORTED.
+	Status *string `json:"status,omitempty"`
+
+	UUID *string `json:"uuid,omitempty"`
+}
@@ -0,0 +1,369 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// record is one flattened entity. Values are strings, int64s, string slices or string maps.
+type record map[string]interface{}
+
+// exportKind describes the records exported for an entity kind, columns are in their default order.
+type exportKind struct {
+	columns []string
+	records func(conn *v3.Client, filter string) ([]record, error)
+}
+
+var exportKinds = map[string]exportKind{
+	"vm": {
+		columns: []string{"name", "uuid", "cluster", "host", "power_state", "num_sockets", "num_vcpus_per_socket",
+			"vcpus", "memory_mib", "disk_count", "disk_total_mib", "nic_subnets", "nic_ips", "categories", "owner",
+			"project", "creation_time"},
+		records: vmRecords,
+	},
+	"image": {
+		columns: []string{"name", "uuid", "state", "image_type", "size_bytes", "source_uri", "cluster", "categories",
+			"owner", "project", "creation_time"},
+		records: imageRecords,
+	},
+	"subnet": {
+		columns: []string{"name", "uuid", "state", "cluster", "subnet_type", "vlan_id", "subnet_ip", "prefix_length",
+			"default_gateway_ip", "categories", "owner", "project"},
+		records: subnetRecords,
+	},
+	"cluster": {
+		columns: []string{"name", "uuid", "state", "node_count", "hypervisor_types", "hypervisor_ips", "nos_version",
+			"categories", "owner"},
+		records: clusterRecords,
+	},
+}
+
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	kind := flags.String("kind", "vm", "entity kind to export: vm, image, subnet or cluster")
+	format := flags.String("format", "jsonl", "output format: jsonl or csv")
+	columns := flags.String("columns", "", "comma separated columns to export, all columns of the kind by default")
+	filter := flags.String("filter", "", "FIQL filter passed to the list API, e.g. vm_name==web.*")
+	out := flags.String("out", "-", "file to write to, - for standard output")
+	flags.Parse(args)
+
+	k, ok := exportKinds[*kind]
+	if !ok {
+		return fmt.Errorf("unknown kind %q, expected one of vm, image, subnet or cluster", *kind)
+	}
+
+	selected := k.columns
+	if *columns != "" {
+		selected = strings.Split(*columns, ",")
+		for _, c := range selected {
+			if !contains(k.columns, c) {
+				return fmt.Errorf("unknown %s column %q, expected one of %s", *kind, c, strings.Join(k.columns, ", "))
+			}
+		}
+	}
+
+	var write func(w io.Writer, columns []string, records []record) error
+	switch *format {
+	case "jsonl":
+		write = writeJSONLines
+	case "csv":
+		write = writeCSV
+	default:
+		return fmt.Errorf("unknown format %q, expected jsonl or csv", *format)
+	}
+
+	conn, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	records, err := k.records(conn, *filter)
+	if err != nil {
+		return fmt.Errorf("error listing %ss: %s", *kind, err)
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "-" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	return write(w, selected, records)
+}
+
+func writeJSONLines(w io.Writer, columns []string, records []record) error {
+	enc := json.NewEncoder(w)
+	for _, r := range records {
+		selected := make(record, len(columns))
+		for _, c := range columns {
+			selected[c] = r[c]
+		}
+		if err := enc.Encode(selected); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeCSV(w io.Writer, columns []string, records []record) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+
+	row := make([]string, len(columns))
+	for _, r := range records {
+		for k, c := range columns {
+			row[k] = csvValue(r[c])
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvValue formats a record value for a CSV cell, lists and maps are joined with semicolons.
+func csvValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []string:
+		return strings.Join(v, ";")
+	case map[string]string:
+		pairs := make([]string, 0, len(v))
+		for k, val := range v {
+			pairs = append(pairs, k+"="+val)
+		}
+		sort.Strings(pairs)
+		return strings.Join(pairs, ";")
+	}
+	return fmt.Sprint(value)
+}
+
+func vmRecords(conn *v3.Client, filter string) ([]record, error) {
+	vms, err := listVMs(conn, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]record, 0, len(vms))
+	for _, vm := range vms {
+		status := vm.Status
+		if status == nil {
+			continue
+		}
+		res := status.Resources
+		if res == nil {
+			res = &v3.VMResourcesDefStatus{}
+		}
+
+		var diskCount, diskTotal int64
+		for _, disk := range res.DiskList {
+			if disk.DeviceProperties != nil && utils.StringValue(disk.DeviceProperties.DeviceType) == "CDROM" {
+				continue
+			}
+			diskCount++
+			if disk.DiskSizeMib != nil {
+				diskTotal += utils.Int64Value(disk.DiskSizeMib)
+			} else {
+				diskTotal += utils.Int64Value(disk.DiskSizeBytes) / 1024 / 1024
+			}
+		}
+
+		subnets, ips := []string{}, []string{}
+		for _, nic := range res.NicList {
+			subnets = append(subnets, referenceName(nic.SubnetReference))
+			for _, ip := range nic.IPEndpointList {
+				ips = append(ips, utils.StringValue(ip.IP))
+			}
+		}
+
+		sockets, perSocket := utils.Int64Value(res.NumSockets), utils.Int64Value(res.NumVcpusPerSocket)
+
+		records = append(records, record{
+			"name":                 utils.StringValue(status.Name),
+			"uuid":                 utils.StringValue(vm.Metadata.UUID),
+			"cluster":              referenceName(status.ClusterReference),
+			"host":                 referenceName(res.HostReference),
+			"power_state":          utils.StringValue(res.PowerState),
+			"num_sockets":          sockets,
+			"num_vcpus_per_socket": perSocket,
+			"vcpus":                sockets * perSocket,
+			"memory_mib":           utils.Int64Value(res.MemorySizeMib),
+			"disk_count":           diskCount,
+			"disk_total_mib":       diskTotal,
+			"nic_subnets":          subnets,
+			"nic_ips":              ips,
+			"categories":           categoriesValue(vm.Metadata.Categories),
+			"owner":                referenceName(vm.Metadata.OwnerReference),
+			"project":              referenceName(vm.Metadata.ProjectReference),
+			"creation_time":        formatTime(vm.Metadata.CreationTime),
+		})
+	}
+
+	return records, nil
+}
+
+func imageRecords(conn *v3.Client, filter string) ([]record, error) {
+	images, err := listImages(conn, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]record, 0, len(images))
+	for _, image := range images {
+		status := image.Status
+		if status == nil || image.Metadata == nil {
+			continue
+		}
+
+		records = append(records, record{
+			"name":          utils.StringValue(status.Name),
+			"uuid":          utils.StringValue(image.Metadata.UUID),
+			"state":         utils.StringValue(status.State),
+			"image_type":    utils.StringValue(status.Resources.ImageType),
+			"size_bytes":    utils.Int64Value(status.Resources.SizeBytes),
+			"source_uri":    utils.StringValue(status.Resources.SourceURI),
+			"cluster":       referenceName(status.ClusterReference),
+			"categories":    categoriesValue(image.Metadata.Categories),
+			"owner":         referenceName(image.Metadata.OwnerReference),
+			"project":       referenceName(image.Metadata.ProjectReference),
+			"creation_time": formatTime(image.Metadata.CreationTime),
+		})
+	}
+
+	return records, nil
+}
+
+func subnetRecords(conn *v3.Client, filter string) ([]record, error) {
+	subnets, err := listSubnets(conn, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]record, 0, len(subnets))
+	for _, subnet := range subnets {
+		status := subnet.Status
+		if status == nil {
+			continue
+		}
+
+		r := record{
+			"name":               utils.StringValue(status.Name),
+			"uuid":               utils.StringValue(subnet.Metadata.UUID),
+			"state":              utils.StringValue(status.State),
+			"cluster":            referenceName(status.ClusterReference),
+			"subnet_type":        "",
+			"vlan_id":            int64(0),
+			"subnet_ip":          "",
+			"prefix_length":      int64(0),
+			"default_gateway_ip": "",
+			"categories":         categoriesValue(subnet.Metadata.Categories),
+			"owner":              referenceName(subnet.Metadata.OwnerReference),
+			"project":            referenceName(subnet.Metadata.ProjectReference),
+		}
+		if res := status.Resources; res != nil {
+			r["subnet_type"] = utils.StringValue(res.SubnetType)
+			r["vlan_id"] = utils.Int64Value(res.VlanID)
+			if res.IPConfig != nil {
+				r["subnet_ip"] = utils.StringValue(res.IPConfig.SubnetIP)
+				r["prefix_length"] = utils.Int64Value(res.IPConfig.PrefixLength)
+				r["default_gateway_ip"] = utils.StringValue(res.IPConfig.DefaultGatewayIP)
+			}
+		}
+
+		records = append(records, r)
+	}
+
+	return records, nil
+}
+
+func clusterRecords(conn *v3.Client, filter string) ([]record, error) {
+	clusters, err := listClusters(conn, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]record, 0, len(clusters))
+	for _, cluster := range clusters {
+		status := cluster.Status
+		if status == nil {
+			continue
+		}
+		res := status.Resources
+		if res == nil {
+			res = &v3.ClusterObj{}
+		}
+
+		types, ips := []string{}, []string{}
+		if res.Nodes != nil {
+			for _, server := range res.Nodes.HypervisorServerList {
+				if t := utils.StringValue(server.Type); !contains(types, t) {
+					types = append(types, t)
+				}
+				ips = append(ips, utils.StringValue(server.IP))
+			}
+		}
+
+		nosVersion := ""
+		if res.Config != nil && res.Config.SoftwareMap != nil && res.Config.SoftwareMap.NOS != nil {
+			nosVersion = utils.StringValue(res.Config.SoftwareMap.NOS.Version)
+		}
+
+		records = append(records, record{
+			"name":             utils.StringValue(status.Name),
+			"uuid":             utils.StringValue(cluster.Metadata.UUID),
+			"state":            utils.StringValue(status.State),
+			"node_count":       int64(len(ips)),
+			"hypervisor_types": types,
+			"hypervisor_ips":   ips,
+			"nos_version":      nosVersion,
+			"categories":       categoriesValue(cluster.Metadata.Categories),
+			"owner":            referenceName(cluster.Metadata.OwnerReference),
+		})
+	}
+
+	return records, nil
+}
+
+// referenceName returns the name of a reference, or its UUID when the API left the name out.
+func referenceName(ref *v3.Reference) string {
+	if ref == nil {
+		return ""
+	}
+	if name := utils.StringValue(ref.Name); name != "" {
+		return name
+	}
+	return utils.StringValue(ref.UUID)
+}
+
+func categoriesValue(categories map[string]string) map[string]string {
+	if categories == nil {
+		return map[string]string{}
+	}
+	return categories
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
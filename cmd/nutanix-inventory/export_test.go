@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+var testRecords = []record{
+	{
+		"name":        "web-01",
+		"uuid":        "6a5b8a3e-2f6d-4d0c-9d3c-0a9a3b1f0c11",
+		"vcpus":       int64(4),
+		"nic_ips":     []string{"10.0.0.10", "10.0.1.10"},
+		"categories":  map[string]string{"Environment": "Production", "AppType": "Web"},
+		"power_state": "ON",
+	},
+	{
+		"name":       "db, primary",
+		"uuid":       "0b4f7c2a-9e1d-4a55-8f3e-5c2d1e0a9b22",
+		"vcpus":      int64(8),
+		"nic_ips":    []string{},
+		"categories": map[string]string{},
+	},
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeCSV(&buf, []string{"name", "vcpus", "nic_ips", "categories", "power_state"}, testRecords); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `name,vcpus,nic_ips,categories,power_state
+web-01,4,10.0.0.10;10.0.1.10,AppType=Web;Environment=Production,ON
+"db, primary",8,,,
+`
+	if buf.String() != expected {
+		t.Errorf("unexpected CSV:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeJSONLines(&buf, []string{"uuid", "nic_ips"}, testRecords); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"nic_ips":["10.0.0.10","10.0.1.10"],"uuid":"6a5b8a3e-2f6d-4d0c-9d3c-0a9a3b1f0c11"}
+{"nic_ips":[],"uuid":"0b4f7c2a-9e1d-4a55-8f3e-5c2d1e0a9b22"}
+`
+	if buf.String() != expected {
+		t.Errorf("unexpected JSON lines:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
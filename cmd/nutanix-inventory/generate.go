@@ -0,0 +1,421 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// generateKinds are the entity kinds the generate command knows about, in the order they are written.
+var generateKinds = []string{"subnet", "image", "vm", "category", "security_rule"}
+
+// generator holds the inventory being turned into configuration.
+type generator struct {
+	conn *v3.Client
+
+	nameRegex  *regexp.Regexp
+	cluster    string
+	categories map[string]string
+
+	names resourceNames
+
+	// refs maps the UUIDs of generated subnets and images to their Terraform address, so that VMs refer to
+	// them instead of to the UUID.
+	refs map[string]string
+
+	// imports are the terraform import commands for every generated resource.
+	imports []string
+}
+
+func runGenerate(args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ExitOnError)
+	out := flags.String("out", ".", "directory the .tf files and import script are written to")
+	kinds := flags.String("kinds", strings.Join(generateKinds, ","), "comma separated entity kinds to generate")
+	nameRegex := flags.String("name-regex", "", "only generate entities whose name matches this regular expression")
+	cluster := flags.String("cluster", "", "only generate VMs and subnets on this cluster, by name or UUID")
+	categories := flags.String("categories", "", "only generate entities with these categories, as key=value,...")
+	vmFilter := flags.String("vm-filter", "", "FIQL filter passed to the VM list, e.g. power_state==on")
+	flags.Parse(args)
+
+	g := &generator{
+		cluster:    *cluster,
+		categories: make(map[string]string),
+		names:      make(resourceNames),
+		refs:       make(map[string]string),
+	}
+
+	var err error
+	if g.nameRegex, err = regexp.Compile(*nameRegex); err != nil {
+		return fmt.Errorf("invalid -name-regex: %s", err)
+	}
+	for _, kv := range strings.Split(*categories, ",") {
+		if kv == "" {
+			continue
+		}
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid -categories entry %q, expected key=value", kv)
+		}
+		g.categories[parts[0]] = parts[1]
+	}
+
+	selected := make(map[string]bool)
+	for _, kind := range strings.Split(*kinds, ",") {
+		if !contains(generateKinds, kind) {
+			return fmt.Errorf("unknown kind %q, expected one of %s", kind, strings.Join(generateKinds, ", "))
+		}
+		selected[kind] = true
+	}
+
+	if g.conn, err = newClient(); err != nil {
+		return err
+	}
+
+	// Subnets and images go first so that the VMs can refer to them.
+	for _, kind := range generateKinds {
+		if !selected[kind] {
+			continue
+		}
+
+		var content string
+		switch kind {
+		case "subnet":
+			content, err = g.subnets()
+		case "image":
+			content, err = g.images()
+		case "vm":
+			content, err = g.vms(*vmFilter)
+		case "category":
+			content, err = g.categoryKeys()
+		case "security_rule":
+			content, err = g.securityRules()
+		}
+		if err != nil {
+			return fmt.Errorf("error generating %s configuration: %s", kind, err)
+		}
+
+		file := filepath.Join(*out, kind+"s.tf")
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			return err
+		}
+		log.Printf("wrote %s", file)
+	}
+
+	script := "#!/bin/sh\n# Imports the resources generated by nutanix-inventory into the Terraform state.\nset -e\n\n" +
+		strings.Join(g.imports, "\n") + "\n"
+	file := filepath.Join(*out, "import.sh")
+	if err := ioutil.WriteFile(file, []byte(script), 0755); err != nil {
+		return err
+	}
+	log.Printf("wrote %s with %d imports", file, len(g.imports))
+
+	return nil
+}
+
+// selected reports whether an entity passes the name, cluster and category filters. Entities that do not
+// belong to a cluster, such as images, are never filtered out by cluster.
+func (g *generator) selected(name string, cluster *v3.Reference, categories map[string]string) bool {
+	if !g.nameRegex.MatchString(name) {
+		return false
+	}
+	if g.cluster != "" && cluster != nil &&
+		utils.StringValue(cluster.UUID) != g.cluster && utils.StringValue(cluster.Name) != g.cluster {
+		return false
+	}
+	for k, v := range g.categories {
+		if categories[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *generator) resource(resourceType, kind, name, uuid string, body hclBody) (string, error) {
+	tfName := g.names.next(kind, name)
+	address := resourceType + "." + tfName
+
+	content, err := hclResource(resourceType, tfName, body)
+	if err != nil {
+		return "", err
+	}
+
+	g.refs[uuid] = address
+	g.imports = append(g.imports, fmt.Sprintf("terraform import %s %s", address, uuid))
+
+	return content, nil
+}
+
+// reference returns the Terraform reference to a generated entity, or the UUID if it was not generated.
+func (g *generator) reference(uuid string) interface{} {
+	if address, ok := g.refs[uuid]; ok {
+		return hclRef(address + ".id")
+	}
+	return uuid
+}
+
+func (g *generator) subnets() (string, error) {
+	subnets, err := listSubnets(g.conn, "")
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	for _, subnet := range subnets {
+		status := subnet.Status
+		if status == nil || !g.selected(utils.StringValue(status.Name), status.ClusterReference, subnet.Metadata.Categories) {
+			continue
+		}
+
+		body := hclBody{
+			{"metadata", hclBody{{"kind", "subnet"}}},
+		}
+		body = body.add("name", utils.StringValue(status.Name))
+		body = body.add("description", utils.StringValue(status.Description))
+		body = body.add("categories", subnet.Metadata.Categories)
+		body = body.add("cluster_reference", clusterReference(status.ClusterReference))
+
+		if res := status.Resources; res != nil {
+			body = body.add("subnet_type", utils.StringValue(res.SubnetType))
+			body = body.add("vlan_id", utils.Int64Value(res.VlanID))
+			body = body.add("vswitch_name", utils.StringValue(res.VswitchName))
+
+			if ip := res.IPConfig; ip != nil {
+				body = body.add("subnet_ip", utils.StringValue(ip.SubnetIP))
+				body = body.add("prefix_length", utils.Int64Value(ip.PrefixLength))
+				body = body.add("default_gateway_ip", utils.StringValue(ip.DefaultGatewayIP))
+
+				var ranges []string
+				for _, pool := range ip.PoolList {
+					ranges = append(ranges, utils.StringValue(pool.Range))
+				}
+				body = body.add("ip_config_pool_list_ranges", ranges)
+
+				if ip.DHCPServerAddress != nil {
+					body = body.add("dhcp_server_address", hclBody{}.add("ip", utils.StringValue(ip.DHCPServerAddress.IP)))
+				}
+				if opts := ip.DHCPOptions; opts != nil {
+					body = body.add("dhcp_options", hclBody{}.
+						add("boot_file_name", utils.StringValue(opts.BootFileName)).
+						add("domain_name", utils.StringValue(opts.DomainName)).
+						add("tftp_server_name", utils.StringValue(opts.TFTPServerName)))
+					body = body.add("dhcp_domain_name_server_list", utils.StringValueSlice(opts.DomainNameServerList))
+					body = body.add("dhcp_domain_search_list", utils.StringValueSlice(opts.DomainSearchList))
+				}
+			}
+		}
+
+		content, err := g.resource("nutanix_subnet", "subnet", utils.StringValue(status.Name),
+			utils.StringValue(subnet.Metadata.UUID), body)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(content)
+		buf.WriteString("\n")
+	}
+
+	return buf.String(), nil
+}
+
+func (g *generator) images() (string, error) {
+	images, err := listImages(g.conn, "")
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	for _, image := range images {
+		status := image.Status
+		if status == nil || image.Metadata == nil ||
+			!g.selected(utils.StringValue(status.Name), nil, image.Metadata.Categories) {
+			continue
+		}
+
+		name := utils.StringValue(status.Name)
+		if utils.StringValue(status.Resources.SourceURI) == "" {
+			log.Printf("image %s was uploaded without a source URI, set source_uri before applying", name)
+		}
+
+		body := hclBody{
+			{"metadata", hclBody{{"kind", "image"}}},
+		}
+		body = body.add("name", name)
+		body = body.add("description", utils.StringValue(status.Description))
+		body = body.add("categories", image.Metadata.Categories)
+		body = body.add("image_type", utils.StringValue(status.Resources.ImageType))
+		body = body.add("source_uri", utils.StringValue(status.Resources.SourceURI))
+
+		content, err := g.resource("nutanix_image", "image", name, utils.StringValue(image.Metadata.UUID), body)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(content)
+		buf.WriteString("\n")
+	}
+
+	return buf.String(), nil
+}
+
+func (g *generator) vms(filter string) (string, error) {
+	vms, err := listVMs(g.conn, filter)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	for _, vm := range vms {
+		// The spec is used rather than the status, it holds what was asked for, e.g. only the IPs that
+		// were assigned and not the ones learned from the guest.
+		spec := vm.Spec
+		if spec == nil || spec.Resources == nil ||
+			!g.selected(utils.StringValue(spec.Name), spec.ClusterReference, vm.Metadata.Categories) {
+			continue
+		}
+		res := spec.Resources
+
+		body := hclBody{
+			{"metadata", hclBody{{"kind", "vm"}}},
+		}
+		body = body.add("name", utils.StringValue(spec.Name))
+		body = body.add("description", utils.StringValue(spec.Description))
+		body = body.add("categories", vm.Metadata.Categories)
+		body = body.add("cluster_reference", clusterReference(spec.ClusterReference))
+		body = body.add("num_vcpus_per_socket", utils.Int64Value(res.NumVcpusPerSocket))
+		body = body.add("num_sockets", utils.Int64Value(res.NumSockets))
+		body = body.add("memory_size_mib", utils.Int64Value(res.MemorySizeMib))
+		body = body.add("power_state", utils.StringValue(res.PowerState))
+		body = body.add("guest_os_id", utils.StringValue(res.GuestOsID))
+		body = body.add("hardware_clock_timezone", utils.StringValue(res.HardwareClockTimezone))
+
+		var nics []hclBody
+		for _, nic := range res.NicList {
+			n := hclBody{}.
+				add("nic_type", utils.StringValue(nic.NicType)).
+				add("model", utils.StringValue(nic.Model)).
+				add("network_function_nic_type", utils.StringValue(nic.NetworkFunctionNicType))
+			if nic.SubnetReference != nil {
+				n = n.add("subnet_reference", hclBody{
+					{"kind", "subnet"},
+					{"uuid", g.reference(utils.StringValue(nic.SubnetReference.UUID))},
+				})
+			}
+
+			var ips []hclBody
+			for _, ip := range nic.IPEndpointList {
+				ips = append(ips, hclBody{}.add("ip", utils.StringValue(ip.IP)).add("type", utils.StringValue(ip.Type)))
+			}
+			nics = append(nics, n.add("ip_endpoint_list", ips))
+		}
+		body = body.add("nic_list", nics)
+
+		var disks []hclBody
+		for _, disk := range res.DiskList {
+			d := hclBody{}
+			if ref := disk.DataSourceReference; ref != nil {
+				d = d.add("data_source_reference", []hclBody{{
+					{"kind", utils.StringValue(ref.Kind)},
+					{"uuid", g.reference(utils.StringValue(ref.UUID))},
+				}})
+			}
+			if ref := disk.VolumeGroupReference; ref != nil {
+				d = d.add("volume_group_reference", []hclBody{{
+					{"kind", "volume_group"},
+					{"uuid", utils.StringValue(ref.UUID)},
+				}})
+			}
+			if props := disk.DeviceProperties; props != nil {
+				p := hclBody{}.add("device_type", utils.StringValue(props.DeviceType))
+				if addr := props.DiskAddress; addr != nil {
+					p = p.add("disk_address", []hclBody{{
+						{"device_index", utils.Int64Value(addr.DeviceIndex)},
+						{"adapter_type", utils.StringValue(addr.AdapterType)},
+					}})
+				}
+				d = d.add("device_properties", []hclBody{p})
+			}
+			disks = append(disks, d.add("disk_size_mib", utils.Int64Value(disk.DiskSizeMib)))
+		}
+		body = body.add("disk_list", disks)
+
+		content, err := g.resource("nutanix_virtual_machine", "vm", utils.StringValue(spec.Name),
+			utils.StringValue(vm.Metadata.UUID), body)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(content)
+		buf.WriteString("\n")
+	}
+
+	return buf.String(), nil
+}
+
+// categoryKeys lists the category keys and values as comments. The provider has no resource for them,
+// categories are set through the categories attribute of the entities or nutanix_category_assignment.
+func (g *generator) categoryKeys() (string, error) {
+	categories, err := listCategories(g.conn)
+	if err != nil {
+		return "", err
+	}
+
+	keys := make([]string, 0, len(categories))
+	for k := range categories {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.WriteString("# Category keys and values defined in Prism Central. The provider does not manage them,\n")
+	buf.WriteString("# they are listed for reference when setting categories on the generated resources.\n#\n")
+	for _, k := range keys {
+		fmt.Fprintf(&buf, "# %s: %s\n", k, strings.Join(categories[k], ", "))
+	}
+
+	return buf.String(), nil
+}
+
+// securityRules lists the network security rules as comments, the provider has no resource for them yet.
+func (g *generator) securityRules() (string, error) {
+	rules, err := listSecurityRules(g.conn)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("# Network security rules defined in Prism Central. The provider does not manage them yet,\n")
+	buf.WriteString("# they are listed so that nothing is left out when reviewing the generated configuration.\n#\n")
+	for _, rule := range rules {
+		if rule.Spec.Name == nil || !g.selected(utils.StringValue(rule.Spec.Name), nil, rule.Metadata.Categories) {
+			continue
+		}
+		fmt.Fprintf(&buf, "# %s (%s)\n", utils.StringValue(rule.Spec.Name), utils.StringValue(rule.Metadata.UUID))
+	}
+
+	return buf.String(), nil
+}
+
+func clusterReference(ref *v3.Reference) hclBody {
+	if ref == nil {
+		return nil
+	}
+	return hclBody{
+		{"kind", "cluster"},
+		{"uuid", utils.StringValue(ref.UUID)},
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
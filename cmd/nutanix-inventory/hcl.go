@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// hclBody is an ordered list of attributes, rendered as the body of a block or of an object value.
+type hclBody []hclAttr
+
+type hclAttr struct {
+	name  string
+	value interface{}
+}
+
+// hclRef is an interpolation such as nutanix_subnet.lan.id, rendered as "${nutanix_subnet.lan.id}".
+type hclRef string
+
+// add appends an attribute, skipping empty strings, zero numbers, nil maps and empty lists so that the
+// generated configuration only holds what is set on the entity.
+func (b hclBody) add(name string, value interface{}) hclBody {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return b
+		}
+	case int64:
+		if v == 0 {
+			return b
+		}
+	case map[string]string:
+		if len(v) == 0 {
+			return b
+		}
+	case []string:
+		if len(v) == 0 {
+			return b
+		}
+	case hclBody:
+		if len(v) == 0 {
+			return b
+		}
+	case []hclBody:
+		if len(v) == 0 {
+			return b
+		}
+	}
+	return append(b, hclAttr{name, value})
+}
+
+// hclResource renders a resource block. It fails on a value type it cannot render.
+func hclResource(resourceType, name string, body hclBody) (string, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "resource %q %q {\n", resourceType, name)
+	if err := writeHCLBody(&buf, body, 1); err != nil {
+		return "", fmt.Errorf("resource %s.%s: %s", resourceType, name, err)
+	}
+	buf.WriteString("}\n")
+	return buf.String(), nil
+}
+
+func writeHCLBody(buf *bytes.Buffer, body hclBody, depth int) error {
+	indent := strings.Repeat("  ", depth)
+
+	// Consecutive single line attributes have their equal signs aligned, like terraform fmt does.
+	for i := 0; i < len(body); {
+		j, width := i, 0
+		for ; j < len(body) && isHCLScalar(body[j].value); j++ {
+			if len(hclKey(body[j].name)) > width {
+				width = len(hclKey(body[j].name))
+			}
+		}
+
+		if j == i {
+			attr := body[i]
+			fmt.Fprintf(buf, "%s%s = ", indent, hclKey(attr.name))
+			if err := writeHCLValue(buf, attr.value, depth); err != nil {
+				return fmt.Errorf("%s: %s", attr.name, err)
+			}
+			buf.WriteString("\n")
+			i++
+			continue
+		}
+
+		for ; i < j; i++ {
+			fmt.Fprintf(buf, "%s%-*s = ", indent, width, hclKey(body[i].name))
+			if err := writeHCLValue(buf, body[i].value, depth); err != nil {
+				return fmt.Errorf("%s: %s", body[i].name, err)
+			}
+			buf.WriteString("\n")
+		}
+	}
+
+	return nil
+}
+
+func writeHCLValue(buf *bytes.Buffer, value interface{}, depth int) error {
+	indent := strings.Repeat("  ", depth)
+
+	switch v := value.(type) {
+	case string:
+		buf.WriteString(hclString(v))
+	case hclRef:
+		buf.WriteString(`"${` + string(v) + `}"`)
+	case int64:
+		fmt.Fprintf(buf, "%d", v)
+	case bool:
+		fmt.Fprintf(buf, "%t", v)
+	case []string:
+		quoted := make([]string, len(v))
+		for k, s := range v {
+			quoted[k] = hclString(s)
+		}
+		buf.WriteString("[" + strings.Join(quoted, ", ") + "]")
+	case map[string]string:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		body := make(hclBody, 0, len(keys))
+		for _, k := range keys {
+			body = append(body, hclAttr{k, v[k]})
+		}
+		return writeHCLValue(buf, body, depth)
+	case hclBody:
+		buf.WriteString("{\n")
+		if err := writeHCLBody(buf, v, depth+1); err != nil {
+			return err
+		}
+		buf.WriteString(indent + "}")
+	case []hclBody:
+		buf.WriteString("[\n")
+		for _, body := range v {
+			buf.WriteString(indent + "  ")
+			if err := writeHCLValue(buf, body, depth+1); err != nil {
+				return err
+			}
+			buf.WriteString(",\n")
+		}
+		buf.WriteString(indent + "]")
+	default:
+		return fmt.Errorf("unsupported HCL value %T", value)
+	}
+
+	return nil
+}
+
+func isHCLScalar(value interface{}) bool {
+	switch value.(type) {
+	case string, hclRef, int64, bool, []string:
+		return true
+	}
+	return false
+}
+
+var hclIdentifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// hclKey quotes attribute names that are not identifiers, such as category names with spaces.
+func hclKey(name string) string {
+	if hclIdentifier.MatchString(name) {
+		return name
+	}
+	return hclString(name)
+}
+
+func hclString(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	s = strings.Replace(s, "\n", `\n`, -1)
+	s = strings.Replace(s, "\r", `\r`, -1)
+	s = strings.Replace(s, "\t", `\t`, -1)
+	s = strings.Replace(s, "${", "$${", -1)
+	return `"` + s + `"`
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceNames hands out Terraform resource names derived from entity names, unique per resource type.
+type resourceNames map[string]bool
+
+func (n resourceNames) next(prefix, name string) string {
+	base := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = strings.Trim(prefix+"_"+base, "_")
+	}
+
+	unique := base
+	for k := 2; n[unique]; k++ {
+		unique = fmt.Sprintf("%s_%d", base, k)
+	}
+	n[unique] = true
+
+	return unique
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestHCLResource(t *testing.T) {
+	body := hclBody{
+		{"metadata", hclBody{{"kind", "vm"}}},
+	}
+	body = body.add("name", `web "01"`)
+	body = body.add("description", "")
+	body = body.add("num_sockets", int64(2))
+	body = body.add("categories", map[string]string{"Environment": "Production", "Cost Center": "${cc}"})
+	body = body.add("nic_list", []hclBody{
+		{{"subnet_reference", hclBody{{"kind", "subnet"}, {"uuid", hclRef("nutanix_subnet.lan.id")}}}},
+	})
+
+	expected := `resource "nutanix_virtual_machine" "web" {
+  metadata = {
+    kind = "vm"
+  }
+  name        = "web \"01\""
+  num_sockets = 2
+  categories = {
+    "Cost Center" = "$${cc}"
+    Environment   = "Production"
+  }
+  nic_list = [
+    {
+      subnet_reference = {
+        kind = "subnet"
+        uuid = "${nutanix_subnet.lan.id}"
+      }
+    },
+  ]
+}
+`
+
+	got, err := hclResource("nutanix_virtual_machine", "web", body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != expected {
+		t.Errorf("unexpected HCL:\n%s\nexpected:\n%s", got, expected)
+	}
+}
+
+func TestHCLResourceUnsupportedValue(t *testing.T) {
+	body := hclBody{
+		{"disk_list", []hclBody{{{"disk_size_mib", 1024.5}}}},
+	}
+
+	_, err := hclResource("nutanix_virtual_machine", "web", body)
+	if err == nil || err.Error() != "resource nutanix_virtual_machine.web: disk_list: disk_size_mib: unsupported HCL value float64" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestResourceNames(t *testing.T) {
+	names := make(resourceNames)
+
+	cases := []struct {
+		name     string
+		expected string
+	}{
+		{"Web-01.example.com", "web_01_example_com"},
+		{"web 01 example com", "web_01_example_com_2"},
+		{"2019 backup", "vm_2019_backup"},
+		{"---", "vm"},
+	}
+
+	for _, c := range cases {
+		if got := names.next("vm", c.name); got != c.expected {
+			t.Errorf("names.next(%q) = %q, expected %q", c.name, got, c.expected)
+		}
+	}
+}
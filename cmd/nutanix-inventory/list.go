@@ -0,0 +1,218 @@
+package main
+
+import (
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// pageSize is the number of entities requested per list call.
+const pageSize = 100
+
+func listVMs(conn *v3.Client, filter string) ([]*v3.VMIntentResource, error) {
+	var vms []*v3.VMIntentResource
+
+	err := utils.ListAll(pageSize, func(offset, length int64) (int, int64, error) {
+		request := &v3.VMListMetadata{
+			Kind:   utils.String("vm"),
+			Length: utils.Int64(length),
+			Offset: utils.Int64(offset),
+		}
+		if filter != "" {
+			request.Filter = utils.String(filter)
+		}
+
+		resp, err := conn.V3.ListVM(request)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		vms = append(vms, resp.Entities...)
+
+		if resp.Metadata == nil {
+			return len(resp.Entities), 0, nil
+		}
+		return len(resp.Entities), utils.Int64Value(resp.Metadata.TotalMatches), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vms, nil
+}
+
+func listImages(conn *v3.Client, filter string) ([]*v3.ImageIntentResource, error) {
+	var images []*v3.ImageIntentResource
+
+	err := utils.ListAll(pageSize, func(offset, length int64) (int, int64, error) {
+		request := &v3.ImageListMetadata{
+			Kind:   utils.String("image"),
+			Length: utils.Int64(length),
+			Offset: utils.Int64(offset),
+		}
+		if filter != "" {
+			request.Filter = utils.String(filter)
+		}
+
+		resp, err := conn.V3.ListImage(request)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		images = append(images, resp.Entities...)
+
+		if resp.Metadata == nil {
+			return len(resp.Entities), 0, nil
+		}
+		return len(resp.Entities), utils.Int64Value(resp.Metadata.TotalMatches), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+func listSubnets(conn *v3.Client, filter string) ([]*v3.SubnetIntentResource, error) {
+	var subnets []*v3.SubnetIntentResource
+
+	err := utils.ListAll(pageSize, func(offset, length int64) (int, int64, error) {
+		request := &v3.SubnetListMetadata{
+			Kind:   utils.String("subnet"),
+			Length: utils.Int64(length),
+			Offset: utils.Int64(offset),
+		}
+		if filter != "" {
+			request.Filter = utils.String(filter)
+		}
+
+		resp, err := conn.V3.ListSubnet(request)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		subnets = append(subnets, resp.Entities...)
+
+		if resp.Metadata == nil {
+			return len(resp.Entities), 0, nil
+		}
+		return len(resp.Entities), utils.Int64Value(resp.Metadata.TotalMatches), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subnets, nil
+}
+
+func listSecurityRules(conn *v3.Client) ([]v3.NetworkSecurityRuleIntentResource, error) {
+	var rules []v3.NetworkSecurityRuleIntentResource
+
+	err := utils.ListAll(pageSize, func(offset, length int64) (int, int64, error) {
+		request := &v3.ListMetadata{
+			Kind:   utils.String("network_security_rule"),
+			Length: utils.Int64(length),
+			Offset: utils.Int64(offset),
+		}
+
+		resp, err := conn.V3.ListNetworkSecurityRule(request)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		rules = append(rules, resp.Entities...)
+
+		return len(resp.Entities), utils.Int64Value(resp.Metadata.TotalMatches), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// listCategories returns the values of every category key that is not system defined.
+func listCategories(conn *v3.Client) (map[string][]string, error) {
+	categories := make(map[string][]string)
+
+	var keys []*v3.CategoryKeyStatus
+	for {
+		resp, err := conn.V3.ListCategories(&v3.CategoryListMetadata{
+			Kind:   utils.String("category"),
+			Length: utils.Int64(pageSize),
+			Offset: utils.Int64(int64(len(keys))),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, resp.Entities...)
+
+		if len(resp.Entities) < pageSize {
+			break
+		}
+	}
+
+	for _, key := range keys {
+		if utils.BoolValue(key.SystemDefined) {
+			continue
+		}
+		name := utils.StringValue(key.Name)
+
+		var values []*v3.CategoryValueStatus
+		for {
+			resp, err := conn.V3.ListCategoryValues(name, &v3.CategoryListMetadata{
+				Kind:   utils.String("category"),
+				Length: utils.Int64(pageSize),
+				Offset: utils.Int64(int64(len(values))),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, resp.Entities...)
+
+			if len(resp.Entities) < pageSize {
+				break
+			}
+		}
+
+		categories[name] = make([]string, 0, len(values))
+		for _, v := range values {
+			categories[name] = append(categories[name], utils.StringValue(v.Value))
+		}
+	}
+
+	return categories, nil
+}
+
+func listClusters(conn *v3.Client, filter string) ([]*v3.ClusterIntentResource, error) {
+	var clusters []*v3.ClusterIntentResource
+
+	err := utils.ListAll(pageSize, func(offset, length int64) (int, int64, error) {
+		request := &v3.ClusterListMetadataOutput{
+			Kind:   utils.String("cluster"),
+			Length: utils.Int64(length),
+			Offset: utils.Int64(offset),
+		}
+		if filter != "" {
+			request.Filter = utils.String(filter)
+		}
+
+		resp, err := conn.V3.ListCluster(request)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		clusters = append(clusters, resp.Entities...)
+
+		if resp.Metadata == nil {
+			return len(resp.Entities), 0, nil
+		}
+		return len(resp.Entities), utils.Int64Value(resp.Metadata.TotalMatches), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return clusters, nil
+}
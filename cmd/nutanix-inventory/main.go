@@ -0,0 +1,94 @@
+// Command nutanix-inventory reads the inventory of a Prism Central through the v3 API.
+//
+// The connection is configured with the same environment variables as the provider: NUTANIX_ENDPOINT,
+// NUTANIX_PORT, NUTANIX_USERNAME, NUTANIX_PASSWORD and NUTANIX_INSECURE.
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+)
+
+const defaultPort = "9440"
+
+// commands are the subcommands of nutanix-inventory, each parses its own flags.
+var commands = map[string]func(args []string) error{
+	"export":   runExport,
+	"generate": runGenerate,
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("nutanix-inventory: ")
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	run, ok := commands[os.Args[1]]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+
+	if err := run(os.Args[2:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(os.Stderr, "usage: nutanix-inventory <command> [flags]\n\ncommands:\n")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s\n", name)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun nutanix-inventory <command> -h for the flags of a command.\n")
+}
+
+// newClient connects to the Prism Central given by the provider environment variables.
+func newClient() (*v3.Client, error) {
+	endpoint := os.Getenv("NUTANIX_ENDPOINT")
+	if endpoint == "" {
+		return nil, fmt.Errorf("NUTANIX_ENDPOINT must be set")
+	}
+
+	port := os.Getenv("NUTANIX_PORT")
+	if port == "" {
+		port = defaultPort
+	}
+
+	insecure := false
+	if v := os.Getenv("NUTANIX_INSECURE"); v != "" {
+		var err error
+		if insecure, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("invalid NUTANIX_INSECURE %q: %s", v, err)
+		}
+	}
+
+	config := nutanix.Config{
+		Endpoint: endpoint,
+		Port:     port,
+		Username: os.Getenv("NUTANIX_USERNAME"),
+		Password: os.Getenv("NUTANIX_PASSWORD"),
+		Insecure: insecure,
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.API, nil
+}
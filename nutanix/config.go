@@ -17,6 +17,8 @@ type Config struct {
 	Password string
 	Port     string
 	Insecure bool
+
+	DefaultCategories map[string]string
 }
 
 // Client ...
@@ -36,7 +38,8 @@ func (c *Config) Client() (*NutanixClient, error) {
 		return nil, err
 	}
 	client := &NutanixClient{
-		API: v3,
+		API:               v3,
+		DefaultCategories: c.DefaultCategories,
 	}
 
 	return client, nil
@@ -45,4 +48,34 @@ func (c *Config) Client() (*NutanixClient, error) {
 //NutanixClient represents the nutanix API client
 type NutanixClient struct {
 	API *v3.Client
+
+	// DefaultCategories are merged into the categories of every VM, image and subnet.
+	DefaultCategories map[string]string
+}
+
+// mergeDefaultCategories returns the provider default categories overlaid with the categories set on the resource.
+func mergeDefaultCategories(defaults map[string]string, categories map[string]interface{}) map[string]string {
+	labels := map[string]string{}
+	for k, v := range defaults {
+		labels[k] = v
+	}
+	for k, v := range categories {
+		labels[k] = v.(string)
+	}
+	return labels
+}
+
+// flattenCategories removes the provider default categories from the categories read from the API, unless the
+// resource sets them itself or the value has drifted, so that the defaults never show up as a diff.
+func flattenCategories(defaults map[string]string, categories map[string]string, configured map[string]interface{}) map[string]string {
+	labels := map[string]string{}
+	for k, v := range categories {
+		if dv, ok := defaults[k]; ok && dv == v {
+			if _, set := configured[k]; !set {
+				continue
+			}
+		}
+		labels[k] = v
+	}
+	return labels
 }
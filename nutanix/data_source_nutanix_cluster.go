@@ -0,0 +1,537 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func dataSourceNutanixCluster() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNutanixClusterRead,
+		Schema: getDataSourceClusterSchema(),
+	}
+}
+
+func dataSourceNutanixClusterRead(d *schema.ResourceData, meta interface{}) error {
+	// Get client connection
+	conn := meta.(*NutanixClient).API
+
+	clusterID, iok := d.GetOk("cluster_id")
+	name, nok := d.GetOk("name")
+	if !iok && !nok {
+		return fmt.Errorf("please provide one of cluster_id or name attributes")
+	}
+
+	uuid := clusterID.(string)
+	if !iok {
+		var err error
+		if uuid, err = findClusterByName(conn, name.(string)); err != nil {
+			return err
+		}
+	}
+
+	// Make request to the API
+	resp, err := conn.V3.GetCluster(uuid)
+	if err != nil {
+		return err
+	}
+
+	m := resp.Metadata
+	metadata := map[string]interface{}{
+		"last_update_time": utils.TimeValue(m.LastUpdateTime).String(),
+		"kind":             utils.StringValue(m.Kind),
+		"uuid":             utils.StringValue(m.UUID),
+		"creation_time":    utils.TimeValue(m.CreationTime).String(),
+		"spec_version":     strconv.Itoa(int(utils.Int64Value(m.SpecVersion))),
+		"spec_hash":        utils.StringValue(m.SpecHash),
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return err
+	}
+	if err := d.Set("api_version", utils.StringValue(resp.APIVersion)); err != nil {
+		return err
+	}
+
+	status := resp.Status
+	if status == nil {
+		status = &v3.ClusterDefStatus{}
+	}
+	if err := d.Set("name", utils.StringValue(status.Name)); err != nil {
+		return err
+	}
+	if err := d.Set("state", utils.StringValue(status.State)); err != nil {
+		return err
+	}
+
+	res := status.Resources
+	if res == nil {
+		res = &v3.ClusterObj{}
+	}
+
+	nodes := make([]map[string]interface{}, 0)
+	if res.Nodes != nil {
+		for _, n := range res.Nodes.HypervisorServerList {
+			nodes = append(nodes, map[string]interface{}{
+				"ip":      utils.StringValue(n.IP),
+				"version": utils.StringValue(n.Version),
+				"type":    utils.StringValue(n.Type),
+			})
+		}
+	}
+	if err := d.Set("nodes", nodes); err != nil {
+		return err
+	}
+
+	for k, v := range flattenClusterConfig(res.Config) {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	for k, v := range flattenClusterNetwork(res.Network) {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(uuid)
+
+	return nil
+}
+
+// listAllClusters pages through the clusters list, passing filter as the server-side FIQL filter.
+func listAllClusters(conn *v3.Client, filter string) ([]*v3.ClusterIntentResource, error) {
+	var clusters []*v3.ClusterIntentResource
+
+	err := utils.ListAll(100, func(offset, length int64) (int, int64, error) {
+		request := &v3.ClusterListMetadataOutput{
+			Kind:   utils.String("cluster"),
+			Length: utils.Int64(length),
+			Offset: utils.Int64(offset),
+		}
+		if filter != "" {
+			request.Filter = utils.String(filter)
+		}
+
+		resp, err := conn.V3.ListCluster(request)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		clusters = append(clusters, resp.Entities...)
+
+		if resp.Metadata == nil {
+			return len(resp.Entities), 0, nil
+		}
+		return len(resp.Entities), utils.Int64Value(resp.Metadata.TotalMatches), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return clusters, nil
+}
+
+// findClusterByName returns the UUID of the cluster with the given name, failing when the name is ambiguous.
+func findClusterByName(conn *v3.Client, name string) (string, error) {
+	log.Printf("[DEBUG] Looking up Cluster: %s", name)
+
+	clusters, err := listAllClusters(conn, fiqlEquals("name", name))
+	if err != nil {
+		return "", err
+	}
+
+	var uuids []string
+	for _, c := range clusters {
+		if c.Status != nil && utils.StringValue(c.Status.Name) == name {
+			uuids = append(uuids, utils.StringValue(c.Metadata.UUID))
+		}
+	}
+
+	return singleMatch("cluster", name, uuids)
+}
+
+// isPrismCentral reports whether a cluster entity is the Prism Central instance rather than a Prism Element cluster.
+func isPrismCentral(config *v3.ClusterConfig) bool {
+	if config == nil {
+		return false
+	}
+	for _, s := range config.ServiceList {
+		if utils.StringValue(s) == "PRISM_CENTRAL" {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenClusterConfig(config *v3.ClusterConfig) map[string]interface{} {
+	c := config
+	if c == nil {
+		c = &v3.ClusterConfig{}
+	}
+
+	clusterType := "PRISM_ELEMENT"
+	if isPrismCentral(c) {
+		clusterType = "PRISM_CENTRAL"
+	}
+
+	build := make(map[string]interface{})
+	if c.Build != nil {
+		build["commit_id"] = utils.StringValue(c.Build.CommitID)
+		build["full_version"] = utils.StringValue(c.Build.FullVersion)
+		build["commit_date"] = utils.StringValue(c.Build.CommitDate)
+		build["version"] = utils.StringValue(c.Build.Version)
+		build["short_commit_id"] = utils.StringValue(c.Build.ShortCommitID)
+		build["build_type"] = utils.StringValue(c.Build.BuildType)
+	}
+
+	nosVersion, nccVersion := "", ""
+	if c.SoftwareMap != nil {
+		if c.SoftwareMap.NOS != nil {
+			nosVersion = utils.StringValue(c.SoftwareMap.NOS.Version)
+		}
+		if c.SoftwareMap.NCC != nil {
+			nccVersion = utils.StringValue(c.SoftwareMap.NCC.Version)
+		}
+	}
+
+	return map[string]interface{}{
+		"cluster_type":         clusterType,
+		"is_prism_central":     clusterType == "PRISM_CENTRAL",
+		"service_list":         utils.StringValueSlice(c.ServiceList),
+		"build":                build,
+		"nos_version":          nosVersion,
+		"ncc_version":          nccVersion,
+		"timezone":             utils.StringValue(c.Timezone),
+		"cluster_arch":         utils.StringValue(c.ClusterArch),
+		"redundancy_factor":    utils.Int64Value(c.RedundancyFactor),
+		"operation_mode":       utils.StringValue(c.OperationMode),
+		"encryption_status":    utils.StringValue(c.EncryptionStatus),
+		"gpu_driver_version":   utils.StringValue(c.GpuDriverVersion),
+		"enabled_feature_list": utils.StringValueSlice(c.EnabledFeatureList),
+		"is_available":         utils.BoolValue(c.IsAvailable),
+	}
+}
+
+func flattenClusterAddress(a *v3.Address) map[string]interface{} {
+	addr := make(map[string]interface{})
+	if a != nil {
+		addr["ip"] = utils.StringValue(a.IP)
+		addr["fqdn"] = utils.StringValue(a.FQDN)
+		addr["port"] = strconv.Itoa(int(utils.Int64Value(a.Port)))
+		addr["ipv6"] = utils.StringValue(a.IPV6)
+	}
+	return addr
+}
+
+func flattenClusterNetwork(network *v3.ClusterNetwork) map[string]interface{} {
+	n := network
+	if n == nil {
+		n = &v3.ClusterNetwork{}
+	}
+
+	proxies := make([]map[string]interface{}, 0)
+	for _, p := range n.HTTPProxyList {
+		username := ""
+		if p.Credentials != nil {
+			username = utils.StringValue(p.Credentials.Username)
+		}
+		proxies = append(proxies, map[string]interface{}{
+			"address":         flattenClusterAddress(p.Address),
+			"proxy_type_list": utils.StringValueSlice(p.ProxyTypeList),
+			"username":        username,
+		})
+	}
+
+	smtp := make(map[string]interface{})
+	smtpAddress := make(map[string]interface{})
+	if n.SMTPServer != nil {
+		smtp["type"] = utils.StringValue(n.SMTPServer.Type)
+		smtp["email_address"] = utils.StringValue(n.SMTPServer.EmailAddress)
+		if n.SMTPServer.Server != nil {
+			smtpAddress = flattenClusterAddress(n.SMTPServer.Server.Address)
+			if n.SMTPServer.Server.Credentials != nil {
+				smtp["username"] = utils.StringValue(n.SMTPServer.Server.Credentials.Username)
+			}
+		}
+	}
+
+	domain := make(map[string]interface{})
+	if n.DomainServer != nil {
+		domain["name"] = utils.StringValue(n.DomainServer.Name)
+		domain["nameserver"] = utils.StringValue(n.DomainServer.Nameserver)
+	}
+
+	return map[string]interface{}{
+		"external_ip":               utils.StringValue(n.ExternalIP),
+		"external_data_services_ip": utils.StringValue(n.ExternalDataServicesIP),
+		"external_subnet":           utils.StringValue(n.ExternalSubnet),
+		"internal_subnet":           utils.StringValue(n.InternalSubnet),
+		"masquerading_ip":           utils.StringValue(n.MasqueradingIP),
+		"masquerading_port":         utils.Int64Value(n.MasqueradingPort),
+		"ntp_server_ip_list":        utils.StringValueSlice(n.NTPServerIPList),
+		"name_server_ip_list":       utils.StringValueSlice(n.NameServerIPList),
+		"nfs_subnet_whitelist":      utils.StringValueSlice(n.NFSSubnetWhitelist),
+		"http_proxy_list":           proxies,
+		"smtp_server":               smtp,
+		"smtp_server_address":       smtpAddress,
+		"domain_server":             domain,
+	}
+}
+
+func getDataSourceClusterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cluster_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"name"},
+		},
+		"name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"cluster_id"},
+		},
+		"api_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"metadata": getComputedMetadataSchema(),
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cluster_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"is_prism_central": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"service_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"nodes": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ip": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"version": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"build": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"commit_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"full_version": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"commit_date": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"version": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"short_commit_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"build_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"nos_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ncc_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"timezone": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cluster_arch": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"redundancy_factor": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"operation_mode": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"encryption_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"gpu_driver_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled_feature_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"is_available": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"external_ip": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"external_data_services_ip": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"external_subnet": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"internal_subnet": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"masquerading_ip": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"masquerading_port": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"ntp_server_ip_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"name_server_ip_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"nfs_subnet_whitelist": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"http_proxy_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"address": getComputedAddressSchema(),
+					"proxy_type_list": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"username": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"smtp_server": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"email_address": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"username": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"smtp_server_address": getComputedAddressSchema(),
+		"domain_server": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"nameserver": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func getComputedAddressSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ip": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"fqdn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"ipv6": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package nutanix
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNutanixClusterDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.nutanix_cluster.by_name", "cluster_id", "data.nutanix_cluster.by_id", "cluster_id"),
+					resource.TestCheckResourceAttrSet("data.nutanix_cluster.by_id", "build.version"),
+					resource.TestCheckResourceAttrSet("data.nutanix_cluster.by_id", "cluster_type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNutanixClusterDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterDataSourceNotFoundConfig,
+				ExpectError: regexp.MustCompile("no cluster found with name"),
+			},
+		},
+	})
+}
+
+const testAccClusterDataSourceConfig = `
+data "nutanix_clusters" "all" {}
+
+data "nutanix_cluster" "by_id" {
+  cluster_id = "${data.nutanix_clusters.all.entities.0.metadata.uuid}"
+}
+
+data "nutanix_cluster" "by_name" {
+  name = "${data.nutanix_cluster.by_id.name}"
+}
+`
+
+const testAccClusterDataSourceNotFoundConfig = `
+data "nutanix_cluster" "test" {
+  name = "this-cluster-does-not-exist"
+}
+`
@@ -288,7 +288,7 @@ func dataSourceNutanixClustersRead(d *schema.ResourceData, meta interface{}) err
 			entity["smtp_server_address"] = smtpServAddr
 		}
 
-		entity["ntp_server_ip_list"] = utils.StringValueSlice(network.NameServerIPList)
+		entity["ntp_server_ip_list"] = utils.StringValueSlice(network.NTPServerIPList)
 		entity["external_subnet"] = utils.StringValue(network.ExternalSubnet)
 		entity["external_data_services_ip"] = utils.StringValue(network.ExternalDataServicesIP)
 		entity["internal_subnet"] = utils.StringValue(network.InternalSubnet)
@@ -0,0 +1,414 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func dataSourceNutanixHost() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNutanixHostRead,
+		Schema: getDataSourceHostSchema(),
+	}
+}
+
+func dataSourceNutanixHostRead(d *schema.ResourceData, meta interface{}) error {
+	// Get client connection
+	conn := meta.(*NutanixClient).API
+
+	hostID, iok := d.GetOk("host_id")
+	name, nok := d.GetOk("name")
+	if !iok && !nok {
+		return fmt.Errorf("please provide one of host_id or name attributes")
+	}
+
+	uuid := hostID.(string)
+	if !iok {
+		hosts, err := listAllHosts(conn, fiqlEquals("name", name.(string)))
+		if err != nil {
+			return err
+		}
+
+		var uuids []string
+		for _, h := range hosts {
+			if h.Status != nil && utils.StringValue(h.Status.Name) == name.(string) {
+				uuids = append(uuids, utils.StringValue(h.Metadata.UUID))
+			}
+		}
+
+		if uuid, err = singleMatch("host", name.(string), uuids); err != nil {
+			return err
+		}
+	}
+
+	// Make request to the API
+	resp, err := conn.V3.GetHost(uuid)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range flattenHost(resp) {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(uuid)
+
+	return nil
+}
+
+// listAllHosts pages through the hosts list so that large clusters are returned in full.
+func listAllHosts(conn *v3.Client, filter string) ([]*v3.HostResponse, error) {
+	var hosts []*v3.HostResponse
+
+	err := utils.ListAll(100, func(offset, length int64) (int, int64, error) {
+		request := &v3.ListMetadata{
+			Kind:   utils.String("host"),
+			Length: utils.Int64(length),
+			Offset: utils.Int64(offset),
+		}
+		if filter != "" {
+			request.Filter = utils.String(filter)
+		}
+
+		log.Printf("[DEBUG] Listing Hosts from offset %d", offset)
+
+		resp, err := conn.V3.ListHost(request)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		hosts = append(hosts, resp.Entities...)
+
+		if resp.Metadata == nil {
+			return len(resp.Entities), 0, nil
+		}
+		return len(resp.Entities), utils.Int64Value(resp.Metadata.TotalMatches), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hosts, nil
+}
+
+// splitHypervisorName splits a hypervisor full name such as "Nutanix 20170830.185" into its type and version.
+func splitHypervisorName(fullName string) (string, string) {
+	i := strings.LastIndex(fullName, " ")
+	if i < 0 {
+		return fullName, ""
+	}
+
+	hypervisorType, version := fullName[:i], fullName[i+1:]
+	if hypervisorType == "Nutanix" {
+		hypervisorType = "AHV"
+	}
+
+	return hypervisorType, version
+}
+
+func flattenHost(h *v3.HostResponse) map[string]interface{} {
+	host := map[string]interface{}{
+		"metadata":          flattenMetadata(h.Metadata),
+		"api_version":       utils.StringValue(h.APIVersion),
+		"cluster_reference": make(map[string]interface{}),
+		"gpu_list":          make([]map[string]interface{}, 0),
+	}
+
+	status := h.Status
+	if status == nil {
+		status = &v3.HostStatus{}
+	}
+	host["name"] = utils.StringValue(status.Name)
+	host["state"] = utils.StringValue(status.State)
+	if status.ClusterReference != nil {
+		host["cluster_reference"] = flattenReference(status.ClusterReference)
+	}
+
+	res := status.Resources
+	if res == nil {
+		res = &v3.HostResources{}
+	}
+	host["serial_number"] = utils.StringValue(res.SerialNumber)
+	host["host_type"] = utils.StringValue(res.HostType)
+	host["cpu_model"] = utils.StringValue(res.CPUModel)
+	host["num_cpu_cores"] = utils.Int64Value(res.NumCPUCores)
+	host["num_cpu_sockets"] = utils.Int64Value(res.NumCPUSockets)
+	host["cpu_capacity_hz"] = utils.Int64Value(res.CPUCapacityHz)
+	host["memory_capacity_mib"] = utils.Int64Value(res.MemoryCapacityMib)
+	host["gpu_driver_version"] = utils.StringValue(res.GpuDriverVersion)
+
+	host["hypervisor_full_name"] = ""
+	host["hypervisor_type"] = ""
+	host["hypervisor_version"] = ""
+	host["hypervisor_ip"] = ""
+	host["num_vms"] = 0
+	if res.Hypervisor != nil {
+		fullName := utils.StringValue(res.Hypervisor.HypervisorFullName)
+		host["hypervisor_full_name"] = fullName
+		host["hypervisor_type"], host["hypervisor_version"] = splitHypervisorName(fullName)
+		host["hypervisor_ip"] = utils.StringValue(res.Hypervisor.IP)
+		host["num_vms"] = utils.Int64Value(res.Hypervisor.NumVms)
+	}
+
+	host["controller_vm_ip"] = ""
+	if res.ControllerVM != nil {
+		host["controller_vm_ip"] = utils.StringValue(res.ControllerVM.IP)
+	}
+
+	host["ipmi_ip"] = ""
+	if res.IPMI != nil {
+		host["ipmi_ip"] = utils.StringValue(res.IPMI.IP)
+	}
+
+	host["block_serial_number"] = ""
+	host["block_model"] = ""
+	if res.Block != nil {
+		host["block_serial_number"] = utils.StringValue(res.Block.BlockSerialNumber)
+		host["block_model"] = utils.StringValue(res.Block.BlockModel)
+	}
+
+	gpus := make([]map[string]interface{}, len(res.GpuList))
+	for k, g := range res.GpuList {
+		consumer := make(map[string]interface{})
+		if g.ConsumerReference != nil {
+			consumer = flattenReference(g.ConsumerReference)
+		}
+		gpus[k] = map[string]interface{}{
+			"uuid":                  utils.StringValue(g.UUID),
+			"vendor":                utils.StringValue(g.Vendor),
+			"name":                  utils.StringValue(g.Name),
+			"mode":                  utils.StringValue(g.Mode),
+			"device_id":             utils.Int64Value(g.DeviceID),
+			"index":                 utils.Int64Value(g.Index),
+			"status":                utils.StringValue(g.Status),
+			"assignable":            utils.BoolValue(g.Assignable),
+			"fraction":              utils.Int64Value(g.Fraction),
+			"frame_buffer_size_mib": utils.Int64Value(g.FrameBufferSizeMib),
+			"num_vgpus_allocated":   utils.Int64Value(g.NumVgpusAllocated),
+			"numa_node":             utils.Int64Value(g.NumaNode),
+			"pci_address":           utils.StringValue(g.PCIAddress),
+			"max_resolution":        utils.StringValue(g.MaxResolution),
+			"consumer_reference":    consumer,
+		}
+	}
+	host["gpu_list"] = gpus
+
+	return host
+}
+
+func getDataSourceHostSchema() map[string]*schema.Schema {
+	s := getHostSchema()
+
+	s["host_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"name"},
+	}
+	s["name"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"host_id"},
+	}
+
+	return s
+}
+
+// getHostSchema returns the computed attributes of a host, shared by nutanix_host and nutanix_hosts.
+func getHostSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"metadata": getComputedMetadataSchema(),
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cluster_reference": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"kind": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"uuid": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"serial_number": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"host_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"block_serial_number": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"block_model": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cpu_model": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"num_cpu_cores": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"num_cpu_sockets": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"cpu_capacity_hz": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"memory_capacity_mib": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"hypervisor_full_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"hypervisor_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"hypervisor_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"hypervisor_ip": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"num_vms": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"controller_vm_ip": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ipmi_ip": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"gpu_driver_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"gpu_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"uuid": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"vendor": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"mode": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"device_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"index": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"assignable": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"fraction": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"frame_buffer_size_mib": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"num_vgpus_allocated": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"numa_node": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"pci_address": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"max_resolution": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"consumer_reference": {
+						Type:     schema.TypeMap,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"kind": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"uuid": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"name": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package nutanix
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNutanixHostDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.nutanix_host.test", "host_id", "data.nutanix_hosts.test", "entities.0.host_id"),
+					resource.TestCheckResourceAttrSet("data.nutanix_host.test", "serial_number"),
+					resource.TestCheckResourceAttrSet("data.nutanix_host.test", "cpu_model"),
+					resource.TestCheckResourceAttrSet("data.nutanix_host.test", "memory_capacity_mib"),
+					resource.TestCheckResourceAttrSet("data.nutanix_host.test", "hypervisor_type"),
+					resource.TestCheckResourceAttrSet("data.nutanix_host.test", "controller_vm_ip"),
+					resource.TestCheckResourceAttrSet("data.nutanix_host.test", "cluster_reference.uuid"),
+				),
+			},
+		},
+	})
+}
+
+const testAccHostDataSourceConfig = `
+data "nutanix_hosts" "test" {}
+
+data "nutanix_host" "test" {
+  host_id = "${data.nutanix_hosts.test.entities.0.host_id}"
+}
+`
@@ -0,0 +1,70 @@
+package nutanix
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func dataSourceNutanixHosts() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNutanixHostsRead,
+		Schema: getDataSourceHostsSchema(),
+	}
+}
+
+func dataSourceNutanixHostsRead(d *schema.ResourceData, meta interface{}) error {
+	// Get client connection
+	conn := meta.(*NutanixClient).API
+
+	// Make request to the API
+	hosts, err := listAllHosts(conn, "")
+	if err != nil {
+		return err
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+
+	entities := make([]map[string]interface{}, 0, len(hosts))
+	for _, h := range hosts {
+		// Hosts cannot be filtered by cluster server side, so match the cluster here.
+		if clusterID != "" && (h.Status == nil || h.Status.ClusterReference == nil ||
+			utils.StringValue(h.Status.ClusterReference.UUID) != clusterID) {
+			continue
+		}
+
+		entity := flattenHost(h)
+		entity["host_id"] = utils.StringValue(h.Metadata.UUID)
+		entities = append(entities, entity)
+	}
+
+	if err := d.Set("entities", entities); err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return nil
+}
+
+func getDataSourceHostsSchema() map[string]*schema.Schema {
+	host := getHostSchema()
+	host["host_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return map[string]*schema.Schema{
+		"cluster_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"entities": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: host,
+			},
+		},
+	}
+}
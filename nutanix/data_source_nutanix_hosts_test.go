@@ -0,0 +1,32 @@
+package nutanix
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNutanixHostsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nutanix_hosts.test", "entities.#"),
+					resource.TestCheckResourceAttrPair(
+						"data.nutanix_hosts.test", "entities.0.cluster_reference.uuid", "data.nutanix_hosts.all", "entities.0.cluster_reference.uuid"),
+				),
+			},
+		},
+	})
+}
+
+const testAccHostsDataSourceConfig = `
+data "nutanix_hosts" "all" {}
+
+data "nutanix_hosts" "test" {
+  cluster_id = "${data.nutanix_hosts.all.entities.0.cluster_reference.uuid}"
+}
+`
@@ -2,10 +2,11 @@ package nutanix
 
 import (
 	"fmt"
+	"log"
 	"strconv"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
@@ -20,10 +21,20 @@ func dataSourceNutanixImageRead(d *schema.ResourceData, meta interface{}) error
 	// Get client connection
 	conn := meta.(*NutanixClient).API
 
-	imageID, ok := d.GetOk("image_id")
+	imageID, iok := d.GetOk("image_id")
+	name, nok := d.GetOk("name")
 
-	if !ok {
-		return fmt.Errorf("please provide the required attribute vm_id")
+	if !iok && !nok {
+		return fmt.Errorf("please provide one of image_id or name attributes")
+	}
+
+	// The categories only narrow down a lookup by name.
+	if !iok {
+		uuid, err := findImageByName(conn, name.(string), "", d.Get("categories").(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		imageID = uuid
 	}
 
 	// Make request to the API
@@ -124,7 +135,7 @@ func dataSourceNutanixImageRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	d.SetId(resource.UniqueId())
+	d.SetId(utils.StringValue(resp.Metadata.UUID))
 
 	return nil
 }
@@ -132,8 +143,10 @@ func dataSourceNutanixImageRead(d *schema.ResourceData, meta interface{}) error
 func getDataSourceImageSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"image_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"name"},
 		},
 		"api_version": {
 			Type:     schema.TypeString,
@@ -177,6 +190,7 @@ func getDataSourceImageSchema() map[string]*schema.Schema {
 		},
 		"categories": {
 			Type:     schema.TypeMap,
+			Optional: true,
 			Computed: true,
 		},
 		"owner_reference": {
@@ -220,8 +234,10 @@ func getDataSourceImageSchema() map[string]*schema.Schema {
 			},
 		},
 		"name": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"image_id"},
 		},
 		"state": {
 			Type:     schema.TypeString,
@@ -346,3 +362,63 @@ func getDataSourceImageSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+// listAllImages pages through the images list, passing filter as the server-side FIQL filter.
+func listAllImages(conn *v3.Client, filter string) ([]*v3.ImageIntentResource, error) {
+	var images []*v3.ImageIntentResource
+
+	err := utils.ListAll(100, func(offset, length int64) (int, int64, error) {
+		request := &v3.ImageListMetadata{
+			Kind:   utils.String("image"),
+			Length: utils.Int64(length),
+			Offset: utils.Int64(offset),
+		}
+		if filter != "" {
+			request.Filter = utils.String(filter)
+		}
+
+		resp, err := conn.V3.ListImage(request)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		images = append(images, resp.Entities...)
+
+		if resp.Metadata == nil {
+			return len(resp.Entities), 0, nil
+		}
+		return len(resp.Entities), utils.Int64Value(resp.Metadata.TotalMatches), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// findImageByName returns the UUID of the only image with the given name and categories, optionally narrowed
+// down to a cluster given by name or UUID.
+func findImageByName(conn *v3.Client, name, cluster string, categories map[string]interface{}) (string, error) {
+	log.Printf("[DEBUG] Looking up Image: %s", name)
+
+	images, err := listAllImages(conn, fiqlEquals("name", name))
+	if err != nil {
+		return "", err
+	}
+
+	var uuids []string
+	for _, image := range images {
+		if image.Status == nil || utils.StringValue(image.Status.Name) != name {
+			continue
+		}
+		if !matchReference(image.Status.ClusterReference, cluster) {
+			continue
+		}
+		if !matchCategories(image.Metadata.Categories, categories) {
+			continue
+		}
+		uuids = append(uuids, utils.StringValue(image.Metadata.UUID))
+	}
+
+	return singleMatch("image", name, uuids)
+}
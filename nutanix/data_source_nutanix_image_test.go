@@ -2,6 +2,7 @@ package nutanix
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -49,3 +50,53 @@ data "nutanix_image" "test" {
 }
 `)
 }
+
+func TestAccNutanixImageDataSource_name(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageDataSourceConfigName(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.nutanix_image.test", "image_id", "nutanix_image.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.nutanix_image.test", "description", "Ubuntu mini ISO"),
+				),
+			},
+			{
+				Config:      testAccImageDataSourceConfigNameNotFound(rInt),
+				ExpectError: regexp.MustCompile("no image found with name"),
+			},
+		},
+	})
+}
+
+func testAccImageDataSourceConfigName(r int) string {
+	return fmt.Sprintf(`
+resource "nutanix_image" "test" {
+  name        = "Ubuntu-%d"
+  description = "Ubuntu mini ISO"
+  source_uri  = "http://archive.ubuntu.com/ubuntu/dists/bionic/main/installer-amd64/current/images/netboot/mini.iso"
+
+  metadata = {
+    kind = "image"
+  }
+}
+
+data "nutanix_image" "test" {
+  name = "${nutanix_image.test.name}"
+}
+`, r)
+}
+
+func testAccImageDataSourceConfigNameNotFound(r int) string {
+	return fmt.Sprintf(`
+data "nutanix_image" "test" {
+  name = "Ubuntu-missing-%d"
+}
+`, r)
+}
@@ -0,0 +1,209 @@
+package nutanix
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func dataSourceNutanixImages() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNutanixImagesRead,
+		Schema: getDataSourceImagesSchema(),
+	}
+}
+
+func dataSourceNutanixImagesRead(d *schema.ResourceData, meta interface{}) error {
+	// Get client connection
+	conn := meta.(*NutanixClient).API
+
+	nameRegex := d.Get("name_regex").(string)
+	imageType := d.Get("image_type").(string)
+	categories := d.Get("categories").(map[string]interface{})
+
+	filter := fiqlFilter(fiqlRegexClause("name", nameRegex))
+	if imageType != "" {
+		filter = fiqlFilter(filter, fmt.Sprintf("image_type==%s", imageType))
+	}
+
+	// Make request to the API
+	images, err := listAllImages(conn, filter)
+	if err != nil {
+		return err
+	}
+
+	// Every filter is applied again here, the server side filter is only used to cut down the pages fetched.
+	re := regexp.MustCompile(nameRegex)
+	var matches []*v3.ImageIntentResource
+	for _, image := range images {
+		if image.Status == nil || image.Metadata == nil || !re.MatchString(utils.StringValue(image.Status.Name)) {
+			continue
+		}
+		if imageType != "" && utils.StringValue(image.Status.Resources.ImageType) != imageType {
+			continue
+		}
+		if !matchCategories(image.Metadata.Categories, categories) {
+			continue
+		}
+		matches = append(matches, image)
+	}
+
+	// Newest first, so entities.0 is the latest image matching the filters.
+	sort.SliceStable(matches, func(i, j int) bool {
+		return utils.TimeValue(matches[i].Metadata.CreationTime).After(utils.TimeValue(matches[j].Metadata.CreationTime))
+	})
+
+	entities := make([]map[string]interface{}, len(matches))
+	ids := make([]string, len(matches))
+	for k, image := range matches {
+		ids[k] = utils.StringValue(image.Metadata.UUID)
+		entities[k] = flattenImageEntity(image)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("entities", entities); err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return nil
+}
+
+func flattenImageEntity(image *v3.ImageIntentResource) map[string]interface{} {
+	res := image.Status.Resources
+
+	productName, productVersion := "", ""
+	if res.Version != nil {
+		productName = utils.StringValue(res.Version.ProductName)
+		productVersion = utils.StringValue(res.Version.ProductVersion)
+	}
+
+	cluster := make(map[string]interface{})
+	if image.Status.ClusterReference != nil {
+		cluster = flattenReference(image.Status.ClusterReference)
+	}
+
+	return map[string]interface{}{
+		"image_id":          utils.StringValue(image.Metadata.UUID),
+		"name":              utils.StringValue(image.Status.Name),
+		"description":       utils.StringValue(image.Status.Description),
+		"state":             utils.StringValue(image.Status.State),
+		"image_type":        utils.StringValue(res.ImageType),
+		"source_uri":        utils.StringValue(res.SourceURI),
+		"size_bytes":        int(utils.Int64Value(res.SizeBytes)),
+		"architecture":      utils.StringValue(res.Architecture),
+		"product_name":      productName,
+		"product_version":   productVersion,
+		"creation_time":     utils.TimeValue(image.Metadata.CreationTime).String(),
+		"categories":        image.Metadata.Categories,
+		"cluster_reference": cluster,
+	}
+}
+
+func getDataSourceImagesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateRegexp,
+		},
+		"image_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateOneOf("DISK_IMAGE", "ISO_IMAGE"),
+		},
+		"categories": {
+			Type:     schema.TypeMap,
+			Optional: true,
+		},
+		"ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"entities": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"image_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"state": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"image_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"source_uri": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"size_bytes": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"architecture": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"product_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"product_version": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"creation_time": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"categories": {
+						Type:     schema.TypeMap,
+						Computed: true,
+					},
+					"cluster_reference": {
+						Type:     schema.TypeMap,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"kind": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"uuid": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"name": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package nutanix
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNutanixImagesDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nutanix_images.test", "entities.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.nutanix_images.test", "entities.0.image_id", "nutanix_image.newer", "id"),
+					resource.TestCheckResourceAttr("data.nutanix_images.test", "entities.0.image_type", "ISO_IMAGE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImagesDataSourceConfig(r int) string {
+	return fmt.Sprintf(`
+resource "nutanix_image" "older" {
+  name        = "golden-%d-v1"
+  description = "Golden image v1"
+  source_uri  = "http://archive.ubuntu.com/ubuntu/dists/bionic/main/installer-amd64/current/images/netboot/mini.iso"
+
+  metadata = {
+    kind = "image"
+  }
+}
+
+resource "nutanix_image" "newer" {
+  name        = "golden-%d-v2"
+  description = "Golden image v2"
+  source_uri  = "http://archive.ubuntu.com/ubuntu/dists/bionic/main/installer-amd64/current/images/netboot/mini.iso"
+
+  metadata = {
+    kind = "image"
+  }
+
+  depends_on = ["nutanix_image.older"]
+}
+
+data "nutanix_images" "test" {
+  name_regex = "^golden-%d-"
+  image_type = "ISO_IMAGE"
+
+  depends_on = ["nutanix_image.newer"]
+}
+`, r, r, r)
+}
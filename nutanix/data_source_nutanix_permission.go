@@ -0,0 +1,103 @@
+package nutanix
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func dataSourceNutanixPermission() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNutanixPermissionRead,
+		Schema: getDataSourcePermissionSchema(),
+	}
+}
+
+func dataSourceNutanixPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	// Get client connection
+	conn := meta.(*NutanixClient).API
+
+	uuid, err := findPermissionByName(conn, d.Get("name").(string))
+	if err != nil {
+		return err
+	}
+
+	// Make request to the API
+	resp, err := conn.V3.GetPermission(uuid)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("metadata", flattenMetadata(resp.Metadata)); err != nil {
+		return err
+	}
+
+	status := resp.Status
+	if status == nil {
+		status = &v3.Permission{}
+	}
+	if err := d.Set("description", utils.StringValue(status.Description)); err != nil {
+		return err
+	}
+	if status.Resources != nil {
+		if err := d.Set("operation", utils.StringValue(status.Resources.Operation)); err != nil {
+			return err
+		}
+		if err := d.Set("kind", utils.StringValue(status.Resources.Kind)); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(uuid)
+
+	return nil
+}
+
+// findPermissionByName returns the UUID of the permission with the given name, e.g. "Create_Virtual_Machine".
+func findPermissionByName(conn *v3.Client, name string) (string, error) {
+	log.Printf("[DEBUG] Looking up Permission: %s", name)
+
+	var uuids []string
+	err := utils.ListAll(100, func(offset, length int64) (int, int64, error) {
+		resp, err := conn.V3.ListPermission(listPage("permission", fiqlEquals("name", name), offset, length))
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, p := range resp.Entities {
+			if p.Spec != nil && utils.StringValue(p.Spec.Name) == name {
+				uuids = append(uuids, utils.StringValue(p.Metadata.UUID))
+			}
+		}
+		return len(resp.Entities), listTotal(resp.Metadata), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return singleMatch("permission", name, uuids)
+}
+
+func getDataSourcePermissionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"metadata": getComputedMetadataSchema(),
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"operation": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"kind": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
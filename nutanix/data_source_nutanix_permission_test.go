@@ -0,0 +1,30 @@
+package nutanix
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNutanixPermissionDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nutanix_permission.test", "id"),
+					resource.TestCheckResourceAttr("data.nutanix_permission.test", "operation", "create"),
+					resource.TestCheckResourceAttr("data.nutanix_permission.test", "kind", "vm"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPermissionDataSourceConfig = `
+data "nutanix_permission" "test" {
+  name = "Create_Virtual_Machine"
+}
+`
@@ -0,0 +1,82 @@
+package nutanix
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNutanixProject() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNutanixProjectRead,
+		Schema: getDataSourceProjectSchema(),
+	}
+}
+
+func dataSourceNutanixProjectRead(d *schema.ResourceData, meta interface{}) error {
+	// Get client connection
+	conn := meta.(*NutanixClient).API
+
+	projectID, idOk := d.GetOk("project_id")
+	name, nameOk := d.GetOk("name")
+
+	if !idOk && !nameOk {
+		return fmt.Errorf("please provide one of project_id or name attributes")
+	}
+
+	uuid := ""
+	if idOk {
+		uuid = projectID.(string)
+	} else {
+		var err error
+		uuid, err = findProjectByName(conn, name.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Make request to the API
+	resp, err := conn.V3.GetProject(uuid)
+	if err != nil {
+		return err
+	}
+
+	if err := setProjectAttributes(d, resp); err != nil {
+		return err
+	}
+
+	if err := d.Set("project_id", uuid); err != nil {
+		return err
+	}
+
+	d.SetId(uuid)
+
+	return nil
+}
+
+func getDataSourceProjectSchema() map[string]*schema.Schema {
+	s := getProjectSchema()
+
+	// Every attribute of the resource is read only here, the project is selected by project_id or name.
+	for _, v := range s {
+		v.Optional = false
+		v.Required = false
+		v.Computed = true
+		v.ValidateFunc = nil
+		v.DiffSuppressFunc = nil
+	}
+	s["project_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"name"},
+	}
+	s["name"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"project_id"},
+	}
+
+	return s
+}
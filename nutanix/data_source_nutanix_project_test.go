@@ -0,0 +1,48 @@
+package nutanix
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNutanixProjectDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.nutanix_project.test", "project_id", "nutanix_project.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.nutanix_project.test", "resource_domain.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectDataSourceConfig(r int) string {
+	return fmt.Sprintf(`
+resource "nutanix_project" "test" {
+  name = "project-data-%d"
+
+  resource_domain = [
+    {
+      resource_type = "STORAGE"
+      limit         = 107374182400
+    },
+  ]
+}
+
+data "nutanix_project" "test" {
+  name = "${nutanix_project.test.name}"
+}
+`, r)
+}
@@ -2,9 +2,11 @@ package nutanix
 
 import (
 	"fmt"
+	"log"
 	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
@@ -19,10 +21,21 @@ func dataSourceNutanixSubnetRead(d *schema.ResourceData, meta interface{}) error
 	// Get client connection
 	conn := meta.(*NutanixClient).API
 
-	subnetID, ok := d.GetOk("subnet_id")
+	subnetID, iok := d.GetOk("subnet_id")
+	name, nok := d.GetOk("name")
 
-	if !ok {
-		return fmt.Errorf("please provide the required attribute vm_id")
+	if !iok && !nok {
+		return fmt.Errorf("please provide one of subnet_id or name attributes")
+	}
+
+	// The cluster and categories only narrow down a lookup by name.
+	if !iok {
+		uuid, err := findSubnetByName(conn, name.(string), d.Get("cluster_id").(string),
+			d.Get("cluster_name").(string), d.Get("categories").(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		subnetID = uuid
 	}
 
 	// Make request to the API
@@ -238,8 +251,10 @@ func dataSourceNutanixSubnetRead(d *schema.ResourceData, meta interface{}) error
 func getDataSourceSubnetSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"subnet_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"name"},
 		},
 		"api_version": {
 			Type:     schema.TypeString,
@@ -281,8 +296,19 @@ func getDataSourceSubnetSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"cluster_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"subnet_id", "cluster_name"},
+		},
+		"cluster_name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"subnet_id", "cluster_id"},
+		},
 		"categories": {
 			Type:     schema.TypeMap,
+			Optional: true,
 			Computed: true,
 		},
 		"owner_reference": {
@@ -326,8 +352,10 @@ func getDataSourceSubnetSchema() map[string]*schema.Schema {
 			},
 		},
 		"name": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"subnet_id"},
 		},
 		"state": {
 			Type:     schema.TypeString,
@@ -502,3 +530,67 @@ func getDataSourceSubnetSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+// listAllSubnets pages through the subnets list, passing filter as the server-side FIQL filter.
+func listAllSubnets(conn *v3.Client, filter string) ([]*v3.SubnetIntentResource, error) {
+	var subnets []*v3.SubnetIntentResource
+
+	err := utils.ListAll(100, func(offset, length int64) (int, int64, error) {
+		request := &v3.SubnetListMetadata{
+			Kind:   utils.String("subnet"),
+			Length: utils.Int64(length),
+			Offset: utils.Int64(offset),
+		}
+		if filter != "" {
+			request.Filter = utils.String(filter)
+		}
+
+		resp, err := conn.V3.ListSubnet(request)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		subnets = append(subnets, resp.Entities...)
+
+		if resp.Metadata == nil {
+			return len(resp.Entities), 0, nil
+		}
+		return len(resp.Entities), utils.Int64Value(resp.Metadata.TotalMatches), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subnets, nil
+}
+
+// findSubnetByName returns the UUID of the only subnet with the given name, optionally narrowed down to
+// a cluster (by UUID or name) and to a set of categories. Subnet names are only unique per cluster.
+func findSubnetByName(conn *v3.Client, name, clusterID, clusterName string, categories map[string]interface{}) (string, error) {
+	log.Printf("[DEBUG] Looking up Subnet: %s", name)
+
+	subnets, err := listAllSubnets(conn, fiqlFilter(fiqlEquals("name", name), fiqlEquals("cluster_name", clusterName)))
+	if err != nil {
+		return "", err
+	}
+
+	var uuids []string
+	for _, subnet := range subnets {
+		if subnet.Status == nil || utils.StringValue(subnet.Status.Name) != name {
+			continue
+		}
+		cluster := subnet.Status.ClusterReference
+		if clusterID != "" && (cluster == nil || utils.StringValue(cluster.UUID) != clusterID) {
+			continue
+		}
+		if clusterName != "" && (cluster == nil || utils.StringValue(cluster.Name) != clusterName) {
+			continue
+		}
+		if !matchCategories(subnet.Metadata.Categories, categories) {
+			continue
+		}
+		uuids = append(uuids, utils.StringValue(subnet.Metadata.UUID))
+	}
+
+	return singleMatch("subnet", name, uuids)
+}
@@ -67,3 +67,57 @@ resource "nutanix_subnet" "test" {
 }
 `, r)
 }
+
+func TestAccNutanixSubnetDataSource_name(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubnetDataSourceConfigName(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.nutanix_subnet.test", "subnet_id", "nutanix_subnet.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.nutanix_subnet.test", "vlan_id", "203"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubnetDataSourceConfigName(r int) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_subnet" "test" {
+  metadata = {
+    kind = "subnet"
+  }
+
+  name        = "vlan_name_lookup_%d"
+  description = "Lookup by name"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  vlan_id     = 203
+  subnet_type = "VLAN"
+
+  prefix_length      = 24
+  default_gateway_ip = "192.168.3.1"
+  subnet_ip          = "192.168.3.0"
+}
+
+data "nutanix_subnet" "test" {
+  name       = "${nutanix_subnet.test.name}"
+  cluster_id = "${var.clusterid}"
+}
+`, r)
+}
@@ -0,0 +1,231 @@
+package nutanix
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func dataSourceNutanixSubnets() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNutanixSubnetsRead,
+		Schema: getDataSourceSubnetsSchema(),
+	}
+}
+
+func dataSourceNutanixSubnetsRead(d *schema.ResourceData, meta interface{}) error {
+	// Get client connection
+	conn := meta.(*NutanixClient).API
+
+	nameRegex := d.Get("name_regex").(string)
+	clusterID := d.Get("cluster_id").(string)
+	clusterName := d.Get("cluster_name").(string)
+	subnetType := d.Get("subnet_type").(string)
+	vlanID, hasVlanID := d.GetOk("vlan_id")
+	categories := d.Get("categories").(map[string]interface{})
+
+	filter := fiqlFilter(fiqlRegexClause("name", nameRegex))
+	if clusterName != "" {
+		filter = fiqlFilter(filter, fiqlEquals("cluster_name", clusterName))
+	}
+	if subnetType != "" {
+		filter = fiqlFilter(filter, fmt.Sprintf("subnet_type==%s", subnetType))
+	}
+	if hasVlanID {
+		filter = fiqlFilter(filter, fmt.Sprintf("vlan_id==%d", vlanID.(int)))
+	}
+
+	// Make request to the API
+	subnets, err := listAllSubnets(conn, filter)
+	if err != nil {
+		return err
+	}
+
+	// Every filter is applied again here, the server side filter is only used to cut down the pages fetched.
+	re := regexp.MustCompile(nameRegex)
+	entities := make([]map[string]interface{}, 0)
+	ids := make([]string, 0)
+	for _, subnet := range subnets {
+		if subnet.Status == nil || !re.MatchString(utils.StringValue(subnet.Status.Name)) {
+			continue
+		}
+		cluster := subnet.Status.ClusterReference
+		if clusterID != "" && (cluster == nil || utils.StringValue(cluster.UUID) != clusterID) {
+			continue
+		}
+		if clusterName != "" && (cluster == nil || utils.StringValue(cluster.Name) != clusterName) {
+			continue
+		}
+		res := subnet.Status.Resources
+		if res == nil {
+			res = &v3.SubnetResourcesDefStatus{}
+		}
+		if subnetType != "" && utils.StringValue(res.SubnetType) != subnetType {
+			continue
+		}
+		if hasVlanID && int(utils.Int64Value(res.VlanID)) != vlanID.(int) {
+			continue
+		}
+		if !matchCategories(subnet.Metadata.Categories, categories) {
+			continue
+		}
+
+		ids = append(ids, utils.StringValue(subnet.Metadata.UUID))
+		entities = append(entities, flattenSubnetEntity(subnet, res))
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("entities", entities); err != nil {
+		return err
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return nil
+}
+
+func flattenSubnetEntity(subnet *v3.SubnetIntentResource, res *v3.SubnetResourcesDefStatus) map[string]interface{} {
+	cluster := make(map[string]interface{})
+	if subnet.Status.ClusterReference != nil {
+		cluster = flattenReference(subnet.Status.ClusterReference)
+	}
+
+	entity := map[string]interface{}{
+		"subnet_id":          utils.StringValue(subnet.Metadata.UUID),
+		"name":               utils.StringValue(subnet.Status.Name),
+		"description":        utils.StringValue(subnet.Status.Description),
+		"state":              utils.StringValue(subnet.Status.State),
+		"subnet_type":        utils.StringValue(res.SubnetType),
+		"vlan_id":            int(utils.Int64Value(res.VlanID)),
+		"vswitch_name":       utils.StringValue(res.VswitchName),
+		"subnet_ip":          "",
+		"prefix_length":      0,
+		"default_gateway_ip": "",
+		"categories":         subnet.Metadata.Categories,
+		"cluster_reference":  cluster,
+	}
+
+	if res.IPConfig != nil {
+		entity["subnet_ip"] = utils.StringValue(res.IPConfig.SubnetIP)
+		entity["prefix_length"] = int(utils.Int64Value(res.IPConfig.PrefixLength))
+		entity["default_gateway_ip"] = utils.StringValue(res.IPConfig.DefaultGatewayIP)
+	}
+
+	return entity
+}
+
+func getDataSourceSubnetsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateRegexp,
+		},
+		"cluster_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"cluster_name"},
+		},
+		"cluster_name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"cluster_id"},
+		},
+		"subnet_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateOneOf("VLAN", "OVERLAY"),
+		},
+		"vlan_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"categories": {
+			Type:     schema.TypeMap,
+			Optional: true,
+		},
+		"ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"entities": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"subnet_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"state": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"subnet_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"vlan_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"vswitch_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"subnet_ip": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"prefix_length": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"default_gateway_ip": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"categories": {
+						Type:     schema.TypeMap,
+						Computed: true,
+					},
+					"cluster_reference": {
+						Type:     schema.TypeMap,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"kind": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"uuid": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"name": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
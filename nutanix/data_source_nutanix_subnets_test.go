@@ -0,0 +1,67 @@
+package nutanix
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNutanixSubnetsDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubnetsDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nutanix_subnets.test", "entities.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.nutanix_subnets.test", "ids.0", "nutanix_subnet.test", "id"),
+					resource.TestCheckResourceAttr("data.nutanix_subnets.test", "entities.0.vlan_id", "204"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubnetsDataSourceConfig(r int) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_subnet" "test" {
+  metadata = {
+    kind = "subnet"
+  }
+
+  name        = "vlan_list_%d"
+  description = "Listed subnet"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  vlan_id     = 204
+  subnet_type = "VLAN"
+
+  prefix_length      = 24
+  default_gateway_ip = "192.168.4.1"
+  subnet_ip          = "192.168.4.0"
+}
+
+data "nutanix_subnets" "test" {
+  name_regex  = "vlan_list_%d"
+  cluster_id  = "${var.clusterid}"
+  subnet_type = "VLAN"
+  vlan_id     = 204
+
+  depends_on = ["nutanix_subnet.test"]
+}
+`, r, r)
+}
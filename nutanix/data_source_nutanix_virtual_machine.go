@@ -2,8 +2,10 @@ package nutanix
 
 import (
 	"fmt"
+	"log"
 	"strconv"
 
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -21,10 +23,20 @@ func dataSourceNutanixVirtualMachineRead(d *schema.ResourceData, meta interface{
 	// Get client connection
 	conn := meta.(*NutanixClient).API
 
-	vm, ok := d.GetOk("vm_id")
+	vm, iok := d.GetOk("vm_id")
+	name, nok := d.GetOk("name")
 
-	if !ok {
-		return fmt.Errorf("please provide the required attribute vm_id")
+	if !iok && !nok {
+		return fmt.Errorf("please provide one of vm_id or name attributes")
+	}
+
+	// The categories only narrow down a lookup by name.
+	if !iok {
+		uuid, err := findVMByName(conn, name.(string), "", d.Get("categories").(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		vm = uuid
 	}
 
 	// Make request to the API
@@ -431,8 +443,10 @@ func dataSourceNutanixVirtualMachineRead(d *schema.ResourceData, meta interface{
 func getDataSourceVMSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"vm_id": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"name"},
 		},
 		"metadata": {
 			Type:     schema.TypeMap,
@@ -472,6 +486,7 @@ func getDataSourceVMSchema() map[string]*schema.Schema {
 		},
 		"categories": {
 			Type:     schema.TypeMap,
+			Optional: true,
 			Computed: true,
 		},
 		"project_reference": {
@@ -519,8 +534,10 @@ func getDataSourceVMSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"name": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"vm_id"},
 		},
 		"description": {
 			Type:     schema.TypeString,
@@ -1036,3 +1053,75 @@ func getDataSourceVMSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+// listAllVMs pages through the VMs list, passing filter as the server-side FIQL filter.
+func listAllVMs(conn *v3.Client, filter string) ([]*v3.VMIntentResource, error) {
+	return listAllVMsSorted(conn, filter, "", "")
+}
+
+// listAllVMsSorted pages through the VMs list like listAllVMs, asking the API to sort the VMs by sortAttribute
+// in sortOrder when those are set.
+func listAllVMsSorted(conn *v3.Client, filter, sortAttribute, sortOrder string) ([]*v3.VMIntentResource, error) {
+	var vms []*v3.VMIntentResource
+
+	err := utils.ListAll(100, func(offset, length int64) (int, int64, error) {
+		request := &v3.VMListMetadata{
+			Kind:   utils.String("vm"),
+			Length: utils.Int64(length),
+			Offset: utils.Int64(offset),
+		}
+		if filter != "" {
+			request.Filter = utils.String(filter)
+		}
+		if sortAttribute != "" {
+			request.SortAttribute = utils.String(sortAttribute)
+		}
+		if sortOrder != "" {
+			request.SortOrder = utils.String(sortOrder)
+		}
+
+		resp, err := conn.V3.ListVM(request)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		vms = append(vms, resp.Entities...)
+
+		if resp.Metadata == nil {
+			return len(resp.Entities), 0, nil
+		}
+		return len(resp.Entities), utils.Int64Value(resp.Metadata.TotalMatches), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vms, nil
+}
+
+// findVMByName returns the UUID of the only VM with the given name and categories, optionally narrowed down
+// to a cluster given by name or UUID.
+func findVMByName(conn *v3.Client, name, cluster string, categories map[string]interface{}) (string, error) {
+	log.Printf("[DEBUG] Looking up VM: %s", name)
+
+	vms, err := listAllVMs(conn, fiqlEquals("vm_name", name))
+	if err != nil {
+		return "", err
+	}
+
+	var uuids []string
+	for _, vm := range vms {
+		if vm.Status == nil || utils.StringValue(vm.Status.Name) != name {
+			continue
+		}
+		if !matchReference(vm.Status.ClusterReference, cluster) {
+			continue
+		}
+		if !matchCategories(vm.Metadata.Categories, categories) {
+			continue
+		}
+		uuids = append(uuids, utils.StringValue(vm.Metadata.UUID))
+	}
+
+	return singleMatch("vm", name, uuids)
+}
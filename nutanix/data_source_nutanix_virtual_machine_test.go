@@ -66,3 +66,53 @@ data "nutanix_virtual_machine" "nutanix_virtual_machine" {
 }
 `, r, r+1)
 }
+
+func TestAccNutanixVMDataSource_name(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVMDataSourceConfigName(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.nutanix_virtual_machine.test", "vm_id", "nutanix_virtual_machine.test", "id"),
+					resource.TestCheckResourceAttr(
+						"data.nutanix_virtual_machine.test", "memory_size_mib", "1024"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVMDataSourceConfigName(r int) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "test" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "vm-lookup-%d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 1024
+  power_state          = "ON"
+}
+
+data "nutanix_virtual_machine" "test" {
+  name = "${nutanix_virtual_machine.test.name}"
+}
+`, r)
+}
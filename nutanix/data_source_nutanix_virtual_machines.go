@@ -1,7 +1,10 @@
 package nutanix
 
 import (
+	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
 
@@ -23,48 +26,124 @@ func dataSourceNutanixVirtualMachinesRead(d *schema.ResourceData, meta interface
 	// Get client connection
 	conn := meta.(*NutanixClient).API
 
-	metadata := &v3.VMListMetadata{}
+	nameRegex := d.Get("name_regex").(string)
+	powerState := d.Get("power_state").(string)
+	cluster := d.Get("cluster").(string)
+	host := d.Get("host").(string)
+	project := d.Get("project").(string)
+	categories := d.Get("categories").(map[string]interface{})
 
+	filter := fiqlFilter(fiqlRegexClause("vm_name", nameRegex))
+	if powerState != "" {
+		filter = fiqlFilter(filter, fmt.Sprintf("power_state==%s", strings.ToLower(powerState)))
+	}
+
+	// The deprecated metadata block is still honoured: its filter is combined with the typed ones, its sort
+	// keys are passed on, and an explicit length or offset fetches that single page only.
+	var page *v3.VMListMetadata
+	sortAttribute, sortOrder := "", ""
 	if v, ok := d.GetOk("metadata"); ok {
 		m := v.(map[string]interface{})
-		metadata.Kind = utils.String("vm")
-		if mv, mok := m["sort_attribute"]; mok {
-			metadata.SortAttribute = utils.String(mv.(string))
-		}
 		if mv, mok := m["filter"]; mok {
-			metadata.Filter = utils.String(mv.(string))
+			filter = fiqlFilter(filter, mv.(string))
 		}
-		if mv, mok := m["length"]; mok {
-			i, err := strconv.Atoi(mv.(string))
-			if err != nil {
-				return err
-			}
-			metadata.Length = utils.Int64(int64(i))
+		if mv, mok := m["sort_attribute"]; mok {
+			sortAttribute = mv.(string)
 		}
 		if mv, mok := m["sort_order"]; mok {
-			metadata.SortOrder = utils.String(mv.(string))
+			sortOrder = mv.(string)
 		}
-		if mv, mok := m["offset"]; mok {
-			i, err := strconv.Atoi(mv.(string))
-			if err != nil {
-				return err
+		_, hasLength := m["length"]
+		_, hasOffset := m["offset"]
+		if hasLength || hasOffset {
+			page = &v3.VMListMetadata{Kind: utils.String("vm")}
+			if sortAttribute != "" {
+				page.SortAttribute = utils.String(sortAttribute)
+			}
+			if sortOrder != "" {
+				page.SortOrder = utils.String(sortOrder)
+			}
+			if mv, mok := m["length"]; mok {
+				i, err := strconv.Atoi(mv.(string))
+				if err != nil {
+					return err
+				}
+				page.Length = utils.Int64(int64(i))
+			}
+			if mv, mok := m["offset"]; mok {
+				i, err := strconv.Atoi(mv.(string))
+				if err != nil {
+					return err
+				}
+				page.Offset = utils.Int64(int64(i))
 			}
-			metadata.Offset = utils.Int64(int64(i))
 		}
 	}
 
 	// Make request to the API
-	resp, err := conn.V3.ListVM(metadata)
-	if err != nil {
-		return err
+	var vms []*v3.VMIntentResource
+	if page != nil {
+		if filter != "" {
+			page.Filter = utils.String(filter)
+		}
+		resp, err := conn.V3.ListVM(page)
+		if err != nil {
+			return err
+		}
+		vms = resp.Entities
+	} else {
+		var err error
+		if vms, err = listAllVMsSorted(conn, filter, sortAttribute, sortOrder); err != nil {
+			return err
+		}
 	}
 
-	if err := d.Set("api_version", resp.APIVersion); err != nil {
+	// Every typed filter is applied again here, the server side filter is only used to cut down the pages fetched.
+	re := regexp.MustCompile(nameRegex)
+	var matches []*v3.VMIntentResource
+	for _, v := range vms {
+		if v.Status == nil || !re.MatchString(utils.StringValue(v.Status.Name)) {
+			continue
+		}
+		if powerState != "" && (v.Status.Resources == nil || utils.StringValue(v.Status.Resources.PowerState) != powerState) {
+			continue
+		}
+		if !matchReference(v.Status.ClusterReference, cluster) {
+			continue
+		}
+		if host != "" && (v.Status.Resources == nil || !matchReference(v.Status.Resources.HostReference, host)) {
+			continue
+		}
+		if !matchReference(v.Metadata.ProjectReference, project) {
+			continue
+		}
+		if !matchCategories(v.Metadata.Categories, categories) {
+			continue
+		}
+		matches = append(matches, v)
+	}
+
+	apiVersion := ""
+	uuids := make([]string, len(matches))
+	names := make([]string, len(matches))
+	for k, v := range matches {
+		apiVersion = utils.StringValue(v.APIVersion)
+		uuids[k] = utils.StringValue(v.Metadata.UUID)
+		names[k] = utils.StringValue(v.Status.Name)
+	}
+
+	if err := d.Set("api_version", apiVersion); err != nil {
+		return err
+	}
+	if err := d.Set("uuids", uuids); err != nil {
+		return err
+	}
+	if err := d.Set("names", names); err != nil {
 		return err
 	}
 
-	entities := make([]map[string]interface{}, len(resp.Entities))
-	for k, v := range resp.Entities {
+	entities := make([]map[string]interface{}, len(matches))
+	for k, v := range matches {
 		entity := make(map[string]interface{})
 		// set metadata values
 		metadata := make(map[string]interface{})
@@ -81,16 +160,19 @@ func dataSourceNutanixVirtualMachinesRead(d *schema.ResourceData, meta interface
 		entity["api_version"] = utils.StringValue(v.APIVersion)
 
 		pr := make(map[string]interface{})
-		pr["kind"] = utils.StringValue(v.Metadata.ProjectReference.Kind)
-		pr["name"] = utils.StringValue(v.Metadata.ProjectReference.Name)
-		pr["uuid"] = utils.StringValue(v.Metadata.ProjectReference.UUID)
-
+		if v.Metadata.ProjectReference != nil {
+			pr["kind"] = utils.StringValue(v.Metadata.ProjectReference.Kind)
+			pr["name"] = utils.StringValue(v.Metadata.ProjectReference.Name)
+			pr["uuid"] = utils.StringValue(v.Metadata.ProjectReference.UUID)
+		}
 		entity["project_reference"] = pr
 
 		or := make(map[string]interface{})
-		or["kind"] = utils.StringValue(v.Metadata.OwnerReference.Kind)
-		or["name"] = utils.StringValue(v.Metadata.OwnerReference.Name)
-		or["uuid"] = utils.StringValue(v.Metadata.OwnerReference.UUID)
+		if v.Metadata.OwnerReference != nil {
+			or["kind"] = utils.StringValue(v.Metadata.OwnerReference.Kind)
+			or["name"] = utils.StringValue(v.Metadata.OwnerReference.Name)
+			or["uuid"] = utils.StringValue(v.Metadata.OwnerReference.UUID)
+		}
 		entity["owner_reference"] = or
 		entity["name"] = utils.StringValue(v.Status.Name)
 		entity["description"] = utils.StringValue(v.Status.Description)
@@ -105,12 +187,17 @@ func dataSourceNutanixVirtualMachinesRead(d *schema.ResourceData, meta interface
 		entity["availability_zone_reference"] = availabilityZoneReference
 		// set cluster reference values
 		clusterReference := make(map[string]interface{})
-		clusterReference["kind"] = utils.StringValue(v.Status.ClusterReference.Kind)
-		clusterReference["name"] = utils.StringValue(v.Status.ClusterReference.Name)
-		clusterReference["uuid"] = utils.StringValue(v.Status.ClusterReference.UUID)
+		if v.Status.ClusterReference != nil {
+			clusterReference["kind"] = utils.StringValue(v.Status.ClusterReference.Kind)
+			clusterReference["name"] = utils.StringValue(v.Status.ClusterReference.Name)
+			clusterReference["uuid"] = utils.StringValue(v.Status.ClusterReference.UUID)
+		}
 		entity["cluster_reference"] = clusterReference
 		entity["state"] = utils.StringValue(v.Status.State)
-		entity["num_vnuma_nodes"] = utils.Int64Value(v.Status.Resources.VnumaConfig.NumVnumaNodes)
+		entity["num_vnuma_nodes"] = 0
+		if v.Status.Resources.VnumaConfig != nil {
+			entity["num_vnuma_nodes"] = utils.Int64Value(v.Status.Resources.VnumaConfig.NumVnumaNodes)
+		}
 
 		// set nic list value
 		nics := v.Status.Resources.NicList
@@ -348,9 +435,46 @@ func dataSourceNutanixVirtualMachinesRead(d *schema.ResourceData, meta interface
 
 func getDataSourceVMSSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
-		"metadata": {
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateRegexp,
+		},
+		"power_state": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateOneOf("ON", "OFF", "PAUSED", "SUSPENDED"),
+		},
+		"cluster": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"host": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"project": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"categories": {
 			Type:     schema.TypeMap,
 			Optional: true,
+		},
+		"uuids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"names": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"metadata": {
+			Type:       schema.TypeMap,
+			Optional:   true,
+			Deprecated: "use the typed filter arguments instead, all pages are fetched when length and offset are not set",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"kind": {
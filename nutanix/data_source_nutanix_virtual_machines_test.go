@@ -1,8 +1,10 @@
 package nutanix
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -133,3 +135,82 @@ data "nutanix_virtual_machines" "basic_web" {
 		length = 2
 	}
 }`
+
+func TestAccNutanixVMSDataSource_filters(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVMSDataSourceConfigFilters(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nutanix_virtual_machines.test", "entities.#", "2"),
+					resource.TestCheckResourceAttr("data.nutanix_virtual_machines.test", "uuids.#", "2"),
+					resource.TestCheckResourceAttr("data.nutanix_virtual_machines.off", "names.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.nutanix_virtual_machines.off", "uuids.0", "nutanix_virtual_machine.off", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVMSDataSourceConfigFilters(r int) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "on" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "filter-%d-on"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 1024
+  power_state          = "ON"
+}
+
+resource "nutanix_virtual_machine" "off" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "filter-%d-off"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 1024
+  power_state          = "OFF"
+}
+
+data "nutanix_virtual_machines" "test" {
+  name_regex = "^filter-%d-"
+  cluster    = "${var.clusterid}"
+
+  depends_on = ["nutanix_virtual_machine.on", "nutanix_virtual_machine.off"]
+}
+
+data "nutanix_virtual_machines" "off" {
+  name_regex  = "^filter-%d-"
+  power_state = "OFF"
+
+  depends_on = ["nutanix_virtual_machine.on", "nutanix_virtual_machine.off"]
+}
+`, r, r, r, r)
+}
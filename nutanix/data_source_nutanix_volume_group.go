@@ -0,0 +1,152 @@
+package nutanix
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNutanixVolumeGroup() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNutanixVolumeGroupRead,
+		Schema: getDataSourceVolumeGroupSchema(),
+	}
+}
+
+func dataSourceNutanixVolumeGroupRead(d *schema.ResourceData, meta interface{}) error {
+	// Get client connection
+	conn := meta.(*NutanixClient).API
+
+	volumeGroupID := d.Get("volume_group_id").(string)
+
+	// Make request to the API
+	resp, err := conn.V3.GetVolumeGroup(volumeGroupID)
+	if err != nil {
+		return err
+	}
+
+	if err := setVolumeGroupAttributes(d, resp); err != nil {
+		return err
+	}
+
+	d.SetId(volumeGroupID)
+
+	return nil
+}
+
+func getDataSourceVolumeGroupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"volume_group_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"api_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"metadata": getComputedMetadataSchema(),
+		"categories": {
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"flash_mode": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"file_system_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sharing_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"iscsi_target_prefix": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"iscsi_target_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"size_mib": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"disk_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"vmdisk_uuid": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"index": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"disk_size_mib": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"storage_container_uuid": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"data_source_reference": {
+						Type:     schema.TypeMap,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"kind": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"uuid": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"iscsi_initiator_name_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"vm_attachment_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"kind": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"uuid": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
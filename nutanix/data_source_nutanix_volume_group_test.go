@@ -0,0 +1,49 @@
+package nutanix
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNutanixVolumeGroupDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupDataSourceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.nutanix_volume_group.test", "name", fmt.Sprintf("vg-data-%d", rInt)),
+					resource.TestCheckResourceAttr(
+						"data.nutanix_volume_group.test", "disk_list.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.nutanix_volume_group.test", "iscsi_target_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVolumeGroupDataSourceConfig(r int) string {
+	return fmt.Sprintf(`
+resource "nutanix_volume_group" "test" {
+  name = "vg-data-%d"
+
+  disk_list = [
+    {
+      disk_size_mib = 10240
+    },
+  ]
+}
+
+data "nutanix_volume_group" "test" {
+  volume_group_id = "${nutanix_volume_group.test.id}"
+}
+`, r)
+}
@@ -0,0 +1,321 @@
+package nutanix
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// userDataHeaders are the first lines cloud-init accepts for user data other than #cloud-config.
+var userDataHeaders = []string{
+	"#!",
+	"#include",
+	"#cloud-boothook",
+	"#upstart-job",
+	"#part-handler",
+	"## template: jinja",
+	"Content-Type: multipart/",
+}
+
+// cloudConfig is the #cloud-config document rendered from the cloud_init block.
+type cloudConfig struct {
+	Hostname          string            `yaml:"hostname,omitempty"`
+	SSHAuthorizedKeys []string          `yaml:"ssh_authorized_keys,omitempty"`
+	Users             []interface{}     `yaml:"users,omitempty"`
+	WriteFiles        []cloudConfigFile `yaml:"write_files,omitempty"`
+	RunCmd            []string          `yaml:"runcmd,omitempty"`
+}
+
+type cloudConfigUser struct {
+	Name              string   `yaml:"name"`
+	Groups            []string `yaml:"groups,omitempty"`
+	Sudo              string   `yaml:"sudo,omitempty"`
+	Shell             string   `yaml:"shell,omitempty"`
+	LockPasswd        *bool    `yaml:"lock_passwd,omitempty"`
+	SSHAuthorizedKeys []string `yaml:"ssh_authorized_keys,omitempty"`
+}
+
+type cloudConfigFile struct {
+	Path        string `yaml:"path"`
+	Content     string `yaml:"content"`
+	Permissions string `yaml:"permissions,omitempty"`
+	Owner       string `yaml:"owner,omitempty"`
+}
+
+// hashGuestCustomization is the StateFunc of the plain text guest customization arguments, the state only keeps
+// a SHA-256 of the payload since it usually carries passwords or keys.
+func hashGuestCustomization(v interface{}) string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// hashEncodedGuestCustomization hashes a payload as returned by the API, so it compares with the hash of the
+// plain text from the configuration.
+func hashEncodedGuestCustomization(encoded string) string {
+	if decoded, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+		return hashGuestCustomization(string(decoded))
+	}
+	return hashGuestCustomization(encoded)
+}
+
+// hashCloudInitState replaces the SSH keys, file contents and commands of the cloud_init block with their
+// hashes once the VM is created. Their StateFunc only applies to the diff, nested values are stored as given, so
+// without this the state would keep them in plain text.
+func hashCloudInitState(d *schema.ResourceData) error {
+	v, ok := d.GetOk("cloud_init")
+	if !ok {
+		return nil
+	}
+
+	block := v.([]interface{})[0].(map[string]interface{})
+	block["ssh_authorized_keys"] = hashGuestCustomizationList(block["ssh_authorized_keys"].([]interface{}))
+	block["runcmd"] = hashGuestCustomizationList(block["runcmd"].([]interface{}))
+	for _, u := range block["users"].([]interface{}) {
+		user := u.(map[string]interface{})
+		user["ssh_authorized_keys"] = hashGuestCustomizationList(user["ssh_authorized_keys"].([]interface{}))
+	}
+	for _, f := range block["write_files"].([]interface{}) {
+		file := f.(map[string]interface{})
+		file["content"] = hashGuestCustomization(file["content"])
+	}
+
+	return d.Set("cloud_init", []interface{}{block})
+}
+
+func hashGuestCustomizationList(l []interface{}) []interface{} {
+	hashed := make([]interface{}, len(l))
+	for k, v := range l {
+		hashed[k] = hashGuestCustomization(v)
+	}
+	return hashed
+}
+
+func validateUserData(v interface{}, k string) (ws []string, es []error) {
+	value := strings.TrimSpace(v.(string))
+
+	if strings.HasPrefix(value, "#cloud-config") {
+		if err := validateYAMLMapping(value); err != nil {
+			es = append(es, fmt.Errorf("%q is not a valid #cloud-config document: %s", k, err))
+		}
+		return
+	}
+	for _, header := range userDataHeaders {
+		if strings.HasPrefix(value, header) {
+			return
+		}
+	}
+
+	if isEncodedPayload(value) {
+		es = append(es, fmt.Errorf("%q must be plain text, it is base64 encoded by the provider", k))
+		return
+	}
+	es = append(es, fmt.Errorf("%q must start with #cloud-config, #! or another cloud-init user data header", k))
+	return
+}
+
+func validateMetaData(v interface{}, k string) (ws []string, es []error) {
+	value := strings.TrimSpace(v.(string))
+
+	if err := validateYAMLMapping(value); err != nil {
+		if isEncodedPayload(value) {
+			es = append(es, fmt.Errorf("%q must be plain text, it is base64 encoded by the provider", k))
+			return
+		}
+		es = append(es, fmt.Errorf("%q must be a YAML or JSON object: %s", k, err))
+	}
+	return
+}
+
+func validateUnattendXML(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+
+	elements := 0
+	decoder := xml.NewDecoder(strings.NewReader(value))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			es = append(es, fmt.Errorf("%q is not well-formed XML: %s", k, err))
+			return
+		}
+		if _, ok := token.(xml.StartElement); ok {
+			elements++
+		}
+	}
+
+	if elements == 0 {
+		if isEncodedPayload(strings.TrimSpace(value)) {
+			es = append(es, fmt.Errorf("%q must be plain text, it is base64 encoded by the provider", k))
+			return
+		}
+		es = append(es, fmt.Errorf("%q does not contain an XML document", k))
+	}
+	return
+}
+
+// validateYAMLMapping checks that a document parses as YAML and is a mapping at the top level.
+func validateYAMLMapping(document string) error {
+	var m map[string]interface{}
+	return yaml.Unmarshal([]byte(document), &m)
+}
+
+// isEncodedPayload reports whether a value is base64 that decodes to text, which is what users passing the
+// encoded payload from the v3 API documentation end up with.
+func isEncodedPayload(value string) bool {
+	if value == "" || strings.ContainsAny(value, " \t\n") {
+		return false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return false
+	}
+	for _, r := range string(decoded) {
+		if r == utf8.RuneError || (r < ' ' && r != '\n' && r != '\r' && r != '\t') {
+			return false
+		}
+	}
+	return true
+}
+
+// renderCloudConfig renders the cloud_init block into a #cloud-config user data document.
+func renderCloudConfig(m map[string]interface{}) (string, error) {
+	config := cloudConfig{
+		Hostname:          m["hostname"].(string),
+		SSHAuthorizedKeys: expandStringList(m["ssh_authorized_keys"].([]interface{})),
+		RunCmd:            expandStringList(m["runcmd"].([]interface{})),
+	}
+
+	users := m["users"].([]interface{})
+	if len(users) > 0 && m["keep_default_user"].(bool) {
+		config.Users = append(config.Users, "default")
+	}
+	for _, u := range users {
+		user := u.(map[string]interface{})
+		cu := cloudConfigUser{
+			Name:              user["name"].(string),
+			Groups:            expandStringList(user["groups"].([]interface{})),
+			Sudo:              user["sudo"].(string),
+			Shell:             user["shell"].(string),
+			SSHAuthorizedKeys: expandStringList(user["ssh_authorized_keys"].([]interface{})),
+		}
+		if !user["lock_passwd"].(bool) {
+			cu.LockPasswd = utils.Bool(false)
+		}
+		config.Users = append(config.Users, cu)
+	}
+
+	for _, f := range m["write_files"].([]interface{}) {
+		file := f.(map[string]interface{})
+		config.WriteFiles = append(config.WriteFiles, cloudConfigFile{
+			Path:        file["path"].(string),
+			Content:     file["content"].(string),
+			Permissions: file["permissions"].(string),
+			Owner:       file["owner"].(string),
+		})
+	}
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return "#cloud-config\n" + string(out), nil
+}
+
+func expandStringList(l []interface{}) []string {
+	s := make([]string, 0, len(l))
+	for _, v := range l {
+		s = append(s, v.(string))
+	}
+	return s
+}
+
+// expandGuestCustomization sets the guest customization from the plain text arguments and the cloud_init block,
+// base64 encoding the payloads as the v3 API expects them.
+func expandGuestCustomization(d *schema.ResourceData, vm *v3.VMResources) error {
+	userData := d.Get("user_data").(string)
+	if v, ok := d.GetOk("cloud_init"); ok {
+		rendered, err := renderCloudConfig(v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return fmt.Errorf("error rendering cloud_init: %s", err)
+		}
+		userData = rendered
+	}
+	metaData := d.Get("meta_data").(string)
+	unattendXML := d.Get("unattend_xml").(string)
+
+	if userData == "" && metaData == "" && unattendXML == "" {
+		return nil
+	}
+	if vm.GuestCustomization == nil {
+		vm.GuestCustomization = &v3.GuestCustomization{}
+	}
+
+	if userData != "" || metaData != "" {
+		vm.GuestCustomization.CloudInit = &v3.GuestCustomizationCloudInit{}
+		if userData != "" {
+			vm.GuestCustomization.CloudInit.UserData = utils.String(base64.StdEncoding.EncodeToString([]byte(userData)))
+		}
+		if metaData != "" {
+			vm.GuestCustomization.CloudInit.MetaData = utils.String(base64.StdEncoding.EncodeToString([]byte(metaData)))
+		}
+	}
+	if unattendXML != "" {
+		installType := d.Get("sysprep_install_type").(string)
+		if installType == "" {
+			installType = "PREPARED"
+		}
+		vm.GuestCustomization.Sysprep = &v3.GuestCustomizationSysprep{
+			InstallType: utils.String(installType),
+			UnattendXML: utils.String(base64.StdEncoding.EncodeToString([]byte(unattendXML))),
+		}
+	}
+
+	return nil
+}
+
+// flattenGuestCustomizationHashes stores the hashes of the payloads the VM was created with. The user data is
+// left alone when it was rendered from the cloud_init block.
+func flattenGuestCustomizationHashes(d *schema.ResourceData, gc *v3.GuestCustomizationStatus) error {
+	if gc == nil {
+		return nil
+	}
+
+	if gc.CloudInit != nil {
+		if _, ok := d.GetOk("cloud_init"); !ok && utils.StringValue(gc.CloudInit.UserData) != "" {
+			if err := d.Set("user_data", hashEncodedGuestCustomization(utils.StringValue(gc.CloudInit.UserData))); err != nil {
+				return err
+			}
+		}
+		if utils.StringValue(gc.CloudInit.MetaData) != "" {
+			if err := d.Set("meta_data", hashEncodedGuestCustomization(utils.StringValue(gc.CloudInit.MetaData))); err != nil {
+				return err
+			}
+		}
+	}
+	if gc.Sysprep != nil && utils.StringValue(gc.Sysprep.UnattendXML) != "" {
+		if err := d.Set("unattend_xml", hashEncodedGuestCustomization(utils.StringValue(gc.Sysprep.UnattendXML))); err != nil {
+			return err
+		}
+		if err := d.Set("sysprep_install_type", utils.StringValue(gc.Sysprep.InstallType)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
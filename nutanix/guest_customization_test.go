@@ -0,0 +1,207 @@
+package nutanix
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestValidateUserData(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string
+		err   string
+	}{
+		{"cloud-config", "#cloud-config\nhostname: web-01\n", ""},
+		{"cloud-config with leading blank lines", "\n\n#cloud-config\nruncmd:\n  - echo ok\n", ""},
+		{"shell script", "#!/bin/bash\necho ok\n", ""},
+		{"include", "#include\nhttp://example.com/user-data\n", ""},
+		{"multipart", "Content-Type: multipart/mixed; boundary=\"b\"\n", ""},
+		{"cloud-config not a mapping", "#cloud-config\n- a\n- b\n", "is not a valid #cloud-config document"},
+		{"cloud-config invalid yaml", "#cloud-config\nhostname: [web\n", "is not a valid #cloud-config document"},
+		{"base64 payload", base64.StdEncoding.EncodeToString([]byte("#cloud-config\nhostname: web-01\n")), "must be plain text"},
+		{"unknown header", "hostname: web-01\n", "must start with #cloud-config"},
+	}
+
+	for _, c := range cases {
+		_, es := validateUserData(c.value, "user_data")
+		if c.err == "" {
+			if len(es) > 0 {
+				t.Errorf("%s: unexpected errors: %v", c.name, es)
+			}
+			continue
+		}
+		if len(es) != 1 || !strings.Contains(es[0].Error(), c.err) {
+			t.Errorf("%s: errors = %v, expected one containing %q", c.name, es, c.err)
+		}
+	}
+}
+
+func TestIsEncodedPayload(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{"encoded text", base64.StdEncoding.EncodeToString([]byte("#cloud-config\nhostname: web-01\n")), true},
+		{"encoded xml", base64.StdEncoding.EncodeToString([]byte("<unattend></unattend>")), true},
+		{"empty", "", false},
+		{"plain text", "#cloud-config", false},
+		{"contains spaces", "aGVsbG8g d29ybGQ=", false},
+		{"not base64", "hello!", false},
+		{"encoded binary", base64.StdEncoding.EncodeToString([]byte{0x00, 0x01, 0xff, 0xfe}), false},
+	}
+
+	for _, c := range cases {
+		if got := isEncodedPayload(c.value); got != c.expected {
+			t.Errorf("%s: isEncodedPayload(%q) = %t, expected %t", c.name, c.value, got, c.expected)
+		}
+	}
+}
+
+func TestRenderCloudConfig(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected string
+	}{
+		{
+			name:     "hostname only",
+			config:   testCloudInitBlock(map[string]interface{}{"hostname": "web-01"}),
+			expected: "#cloud-config\nhostname: web-01\n",
+		},
+		{
+			name: "users keep the default user",
+			config: testCloudInitBlock(map[string]interface{}{
+				"keep_default_user": true,
+				"users": []interface{}{
+					testCloudInitUser(map[string]interface{}{
+						"name":   "deploy",
+						"groups": []interface{}{"wheel"},
+						"sudo":   "ALL=(ALL) NOPASSWD:ALL",
+					}),
+				},
+			}),
+			expected: "#cloud-config\nusers:\n- default\n- name: deploy\n  groups:\n  - wheel\n" +
+				"  sudo: ALL=(ALL) NOPASSWD:ALL\n",
+		},
+		{
+			name: "unlocked password and files",
+			config: testCloudInitBlock(map[string]interface{}{
+				"users": []interface{}{
+					testCloudInitUser(map[string]interface{}{"name": "ops", "lock_passwd": false}),
+				},
+				"write_files": []interface{}{
+					map[string]interface{}{
+						"path":        "/etc/motd",
+						"content":     "hello\n",
+						"permissions": "0644",
+						"owner":       "",
+					},
+				},
+				"runcmd": []interface{}{"systemctl restart sshd"},
+			}),
+			expected: "#cloud-config\nusers:\n- name: ops\n  lock_passwd: false\n" +
+				"write_files:\n- path: /etc/motd\n  content: |\n    hello\n  permissions: \"0644\"\n" +
+				"runcmd:\n- systemctl restart sshd\n",
+		},
+	}
+
+	for _, c := range cases {
+		got, err := renderCloudConfig(c.config)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+		if got != c.expected {
+			t.Errorf("%s: unexpected document:\n%s\nexpected:\n%s", c.name, got, c.expected)
+		}
+	}
+}
+
+func TestHashCloudInitState(t *testing.T) {
+	raw := map[string]interface{}{
+		"cloud_init": []interface{}{
+			map[string]interface{}{
+				"hostname":            "web-01",
+				"ssh_authorized_keys": []interface{}{"ssh-ed25519 AAAA root"},
+				"users": []interface{}{
+					map[string]interface{}{"name": "ops", "ssh_authorized_keys": []interface{}{"ssh-ed25519 AAAA ops"}},
+				},
+				"write_files": []interface{}{
+					map[string]interface{}{"path": "/etc/app.conf", "content": "password=secret"},
+				},
+				"runcmd": []interface{}{"echo secret | passwd --stdin ops"},
+			},
+		},
+	}
+
+	// Only the cloud_init argument, so the other ForceNew arguments of the VM do not turn the diff into a new
+	// resource.
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{"cloud_init": resourceNutanixVirtualMachine().Schema["cloud_init"]},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId("vm")
+	if err := hashCloudInitState(d); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	state := d.State()
+	for k, v := range state.Attributes {
+		if strings.Contains(v, "secret") || strings.Contains(v, "AAAA") {
+			t.Errorf("%s is stored in plain text: %q", k, v)
+		}
+	}
+	if got := state.Attributes["cloud_init.0.hostname"]; got != "web-01" {
+		t.Errorf("cloud_init.0.hostname = %q, expected it to be kept", got)
+	}
+
+	c, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	diff, err := r.Diff(state, terraform.NewResourceConfig(c))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff != nil {
+		t.Errorf("unexpected diff for the same configuration: %#v", diff.Attributes)
+	}
+}
+
+// testCloudInitBlock returns a cloud_init block as read from the configuration, with every unset argument at
+// its zero value.
+func testCloudInitBlock(values map[string]interface{}) map[string]interface{} {
+	m := map[string]interface{}{
+		"hostname":            "",
+		"ssh_authorized_keys": []interface{}{},
+		"keep_default_user":   false,
+		"users":               []interface{}{},
+		"write_files":         []interface{}{},
+		"runcmd":              []interface{}{},
+	}
+	for k, v := range values {
+		m[k] = v
+	}
+	return m
+}
+
+func testCloudInitUser(values map[string]interface{}) map[string]interface{} {
+	m := map[string]interface{}{
+		"name":                "",
+		"groups":              []interface{}{},
+		"sudo":                "",
+		"shell":               "",
+		"lock_passwd":         true,
+		"ssh_authorized_keys": []interface{}{},
+	}
+	for k, v := range values {
+		m[k] = v
+	}
+	return m
+}
@@ -0,0 +1,88 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+)
+
+const importNamePrefix = "name:"
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// nameLookupFunc returns the UUID of the only entity with the given name. cluster is a cluster name or UUID
+// the entity has to live on, or empty when any cluster will do.
+type nameLookupFunc func(conn *v3.Client, name, cluster string) (string, error)
+
+// importStateByName returns an importer that accepts, besides the UUID of the entity, either
+// name:<name> or <cluster>/<name>. Names are resolved through the list API of the entity and the
+// import fails when they match no entity or more than one.
+func importStateByName(lookup nameLookupFunc) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			uuid, err := resolveImportID(meta.(*NutanixClient).API, d.Id(), lookup)
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(uuid)
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// resolveImportID turns an import ID into the UUID of the entity. IDs that are neither name:<name> nor
+// <cluster>/<name> are returned as they are.
+func resolveImportID(conn *v3.Client, id string, lookup nameLookupFunc) (string, error) {
+	name, cluster := "", ""
+	switch {
+	case strings.HasPrefix(id, importNamePrefix):
+		// Everything after the prefix is the name, so names containing a slash can still be imported.
+		name = strings.TrimPrefix(id, importNamePrefix)
+	case !uuidRegexp.MatchString(id) && strings.Contains(id, "/"):
+		parts := strings.SplitN(id, "/", 2)
+		cluster, name = parts[0], parts[1]
+		if cluster == "" {
+			return "", fmt.Errorf("expected import ID in the form <cluster>/<name>, got %q", id)
+		}
+	default:
+		return id, nil
+	}
+
+	if name == "" {
+		return "", fmt.Errorf("import ID %q does not contain a name", id)
+	}
+
+	log.Printf("[DEBUG] Resolving import ID: %s", id)
+
+	return lookup(conn, name, cluster)
+}
+
+// unscopedLookup adapts a finder for entities that do not belong to a cluster, such as projects or roles.
+func unscopedLookup(kind string, find func(conn *v3.Client, name string) (string, error)) nameLookupFunc {
+	return func(conn *v3.Client, name, cluster string) (string, error) {
+		if cluster != "" {
+			return "", fmt.Errorf("%s is not scoped to a cluster, import it by UUID or %s<name>", kind, importNamePrefix)
+		}
+		return find(conn, name)
+	}
+}
+
+func lookupVM(conn *v3.Client, name, cluster string) (string, error) {
+	return findVMByName(conn, name, cluster, nil)
+}
+
+func lookupImage(conn *v3.Client, name, cluster string) (string, error) {
+	return findImageByName(conn, name, cluster, nil)
+}
+
+func lookupSubnet(conn *v3.Client, name, cluster string) (string, error) {
+	if uuidRegexp.MatchString(cluster) {
+		return findSubnetByName(conn, name, cluster, "", nil)
+	}
+	return findSubnetByName(conn, name, "", cluster, nil)
+}
@@ -1,10 +1,15 @@
 package nutanix
 
 import (
+	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+// vmMutexKV serializes the read-modify-write updates of a VM spec, keyed by VM UUID, so resources attaching
+// devices to the same VM don't overwrite each other's changes.
+var vmMutexKV = mutexkv.NewMutexKV()
+
 // Provider function returns the object that implements the terraform.ResourceProvider interface, specifically a schema.Provider
 func Provider() terraform.ResourceProvider {
 
@@ -42,18 +47,40 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("NUTANIX_ENDPOINT", nil),
 				Description: descriptions["endpoint"],
 			},
+			"default_categories": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: descriptions["default_categories"],
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"nutanix_virtual_machine":  dataSourceNutanixVirtualMachine(),
 			"nutanix_virtual_machines": dataSourceNutanixVirtualMachines(),
 			"nutanix_image":            dataSourceNutanixImage(),
+			"nutanix_images":           dataSourceNutanixImages(),
 			"nutanix_subnet":           dataSourceNutanixSubnet(),
+			"nutanix_subnets":          dataSourceNutanixSubnets(),
 			"nutanix_clusters":         dataSourceNutanixClusters(),
+			"nutanix_cluster":          dataSourceNutanixCluster(),
+			"nutanix_volume_group":     dataSourceNutanixVolumeGroup(),
+			"nutanix_project":          dataSourceNutanixProject(),
+			"nutanix_permission":       dataSourceNutanixPermission(),
+			"nutanix_host":             dataSourceNutanixHost(),
+			"nutanix_hosts":            dataSourceNutanixHosts(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"nutanix_virtual_machine": resourceNutanixVirtualMachine(),
-			"nutanix_image":           resourceNutanixImage(),
-			"nutanix_subnet":          resourceNutanixSubnet(),
+			"nutanix_virtual_machine":       resourceNutanixVirtualMachine(),
+			"nutanix_image":                 resourceNutanixImage(),
+			"nutanix_subnet":                resourceNutanixSubnet(),
+			"nutanix_category_assignment":   resourceNutanixCategoryAssignment(),
+			"nutanix_volume_group":          resourceNutanixVolumeGroup(),
+			"nutanix_project":               resourceNutanixProject(),
+			"nutanix_role":                  resourceNutanixRole(),
+			"nutanix_access_control_policy": resourceNutanixAccessControlPolicy(),
+			"nutanix_user":                  resourceNutanixUser(),
+			"nutanix_vm_power_action":       resourceNutanixVMPowerAction(),
+			"nutanix_vm_disk":               resourceNutanixVMDisk(),
+			"nutanix_vm_nic":                resourceNutanixVMNic(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
@@ -78,6 +105,9 @@ func init() {
 			"note, this is never the data services VIP, and should not be an\n" +
 			"individual CVM address, as this would cause calls to fail during\n" +
 			"cluster lifecycle management operations, such as AOS upgrades.",
+
+		"default_categories": "Categories applied to every VM, image and subnet managed by this provider.\n" +
+			"Categories set on the resource itself take precedence.",
 	}
 }
 
@@ -92,5 +122,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Port:     d.Get("port").(string),
 	}
 
+	if v, ok := d.GetOk("default_categories"); ok {
+		config.DefaultCategories = make(map[string]string)
+		for k, val := range v.(map[string]interface{}) {
+			config.DefaultCategories[k] = val.(string)
+		}
+	}
+
 	return config.Client()
 }
@@ -0,0 +1,560 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func resourceNutanixAccessControlPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNutanixAccessControlPolicyCreate,
+		Read:     resourceNutanixAccessControlPolicyRead,
+		Update:   resourceNutanixAccessControlPolicyUpdate,
+		Delete:   resourceNutanixAccessControlPolicyDelete,
+		Importer: importStateByName(unscopedLookup("access_control_policy", findAccessControlPolicyByName)),
+		Schema:   getAccessControlPolicySchema(),
+	}
+}
+
+func resourceNutanixAccessControlPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Creating Access Control Policy: %s", d.Get("name").(string))
+
+	conn := meta.(*NutanixClient).API
+
+	spec, err := getAccessControlPolicySpec(conn, d)
+	if err != nil {
+		return err
+	}
+
+	request := &v3.AccessControlPolicyInput{
+		Metadata: &v3.Metadata{
+			Kind: utils.String("access_control_policy"),
+		},
+		Spec: spec,
+	}
+
+	if v, ok := d.GetOk("api_version"); ok {
+		request.APIVersion = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("categories"); ok {
+		request.Metadata.Categories = expandCategoryAssignment(v.(map[string]interface{}))
+	}
+
+	utils.PrintToJSON(request, "CREATE METHOD REQUEST")
+
+	resp, err := conn.V3.CreateAccessControlPolicy(request)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(utils.StringValue(resp.Metadata.UUID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    accessControlPolicyStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for access control policy (%s) to create: %s", d.Id(), err)
+	}
+
+	return resourceNutanixAccessControlPolicyRead(d, meta)
+}
+
+func resourceNutanixAccessControlPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Reading Access Control Policy: %s", d.Id())
+
+	conn := meta.(*NutanixClient).API
+
+	resp, err := conn.V3.GetAccessControlPolicy(d.Id())
+	if err != nil {
+		if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if err := d.Set("metadata", flattenMetadata(resp.Metadata)); err != nil {
+		return err
+	}
+	if err := d.Set("categories", resp.Metadata.Categories); err != nil {
+		return err
+	}
+	if err := d.Set("api_version", utils.StringValue(resp.APIVersion)); err != nil {
+		return err
+	}
+
+	status := resp.Status
+	if status == nil {
+		status = &v3.AccessControlPolicyStatus{}
+	}
+	if err := d.Set("name", utils.StringValue(status.Name)); err != nil {
+		return err
+	}
+	if err := d.Set("description", utils.StringValue(status.Description)); err != nil {
+		return err
+	}
+	if err := d.Set("state", utils.StringValue(status.State)); err != nil {
+		return err
+	}
+
+	res := status.Resources
+	if res == nil {
+		res = &v3.AccessControlPolicyResources{}
+	}
+	if res.RoleReference != nil {
+		if err := d.Set("role_reference", flattenReference(res.RoleReference)); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("user_reference_list", flattenReferenceList(res.UserReferenceList)); err != nil {
+		return err
+	}
+	if err := d.Set("user_group_reference_list", flattenReferenceList(res.UserGroupReferenceList)); err != nil {
+		return err
+	}
+
+	return d.Set("context_list", flattenFilterList(res.FilterList))
+}
+
+func resourceNutanixAccessControlPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	log.Printf("[DEBUG] Updating Access Control Policy: %s", d.Id())
+
+	resp, err := conn.V3.GetAccessControlPolicy(d.Id())
+	if err != nil {
+		return err
+	}
+
+	spec, err := getAccessControlPolicySpec(conn, d)
+	if err != nil {
+		return err
+	}
+
+	request := &v3.AccessControlPolicyInput{
+		APIVersion: resp.APIVersion,
+		Metadata:   resp.Metadata,
+		Spec:       spec,
+	}
+
+	if d.HasChange("categories") {
+		request.Metadata.Categories = expandCategoryAssignment(d.Get("categories").(map[string]interface{}))
+	}
+
+	utils.PrintToJSON(request, "UPDATE METHOD REQUEST")
+
+	if _, err := conn.V3.UpdateAccessControlPolicy(d.Id(), request); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    accessControlPolicyStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for access control policy (%s) to update: %s", d.Id(), err)
+	}
+
+	return resourceNutanixAccessControlPolicyRead(d, meta)
+}
+
+func resourceNutanixAccessControlPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	log.Printf("[DEBUG] Deleting Access Control Policy: %s", d.Id())
+
+	if err := conn.V3.DeleteAccessControlPolicy(d.Id()); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING", "DELETE_IN_PROGRESS", "COMPLETE"},
+		Target:     []string{"DELETED"},
+		Refresh:    accessControlPolicyStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for access control policy (%s) to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getAccessControlPolicySpec builds the policy spec, resolving a role given by name to its UUID.
+func getAccessControlPolicySpec(conn *v3.Client, d *schema.ResourceData) (*v3.AccessControlPolicy, error) {
+	role := expandReference(d.Get("role_reference").(map[string]interface{}), "role")
+	if role.UUID == nil {
+		if role.Name == nil {
+			return nil, fmt.Errorf("role_reference requires either uuid or name")
+		}
+		uuid, err := findRoleByName(conn, utils.StringValue(role.Name))
+		if err != nil {
+			return nil, err
+		}
+		role.UUID = utils.String(uuid)
+	}
+
+	spec := &v3.AccessControlPolicy{
+		Name: utils.String(d.Get("name").(string)),
+		Resources: &v3.AccessControlPolicyResources{
+			RoleReference:          role,
+			UserReferenceList:      expandReferenceList(d.Get("user_reference_list").([]interface{}), "user"),
+			UserGroupReferenceList: expandReferenceList(d.Get("user_group_reference_list").([]interface{}), "user_group"),
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		spec.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("context_list"); ok {
+		spec.Resources.FilterList = expandFilterList(v.([]interface{}))
+	}
+
+	return spec, nil
+}
+
+func expandFilterList(contexts []interface{}) *v3.FilterList {
+	filter := &v3.FilterList{}
+
+	for _, c := range contexts {
+		ctx := c.(map[string]interface{})
+		context := &v3.ContextList{
+			EntityFilterExpressionList: make([]*v3.EntityFilterExpression, 0),
+		}
+
+		for _, s := range ctx["scope_filter_expression_list"].([]interface{}) {
+			expr := s.(map[string]interface{})
+			context.ScopeFilterExpressionList = append(context.ScopeFilterExpressionList, &v3.ScopeFilterExpression{
+				LeftHandSide:  utils.String(expr["left_hand_side"].(string)),
+				Operator:      utils.String(expr["operator"].(string)),
+				RightHandSide: expandRightHandSide(expr["right_hand_side"].([]interface{})),
+			})
+		}
+
+		for _, e := range ctx["entity_filter_expression_list"].([]interface{}) {
+			expr := e.(map[string]interface{})
+			context.EntityFilterExpressionList = append(context.EntityFilterExpressionList, &v3.EntityFilterExpression{
+				LeftHandSide: &v3.LeftHandSide{
+					EntityType: utils.String(expr["left_hand_side_entity_type"].(string)),
+				},
+				Operator:      utils.String(expr["operator"].(string)),
+				RightHandSide: expandRightHandSide(expr["right_hand_side"].([]interface{})),
+			})
+		}
+
+		filter.ContextList = append(filter.ContextList, context)
+	}
+
+	return filter
+}
+
+func expandRightHandSide(rhs []interface{}) *v3.RightHandSide {
+	r := &v3.RightHandSide{}
+	if len(rhs) == 0 || rhs[0] == nil {
+		return r
+	}
+
+	m := rhs[0].(map[string]interface{})
+	if v, ok := m["collection"]; ok && v.(string) != "" {
+		r.Collection = utils.String(v.(string))
+	}
+	if v, ok := m["uuid_list"]; ok {
+		for _, u := range v.([]interface{}) {
+			r.UUIDList = append(r.UUIDList, u.(string))
+		}
+	}
+	if v, ok := m["categories"]; ok {
+		for _, c := range v.([]interface{}) {
+			category := c.(map[string]interface{})
+			if r.Categories == nil {
+				r.Categories = make(map[string][]string)
+			}
+			name := category["name"].(string)
+			for _, val := range category["value"].([]interface{}) {
+				r.Categories[name] = append(r.Categories[name], val.(string))
+			}
+		}
+	}
+
+	return r
+}
+
+func flattenFilterList(filter *v3.FilterList) []map[string]interface{} {
+	contexts := make([]map[string]interface{}, 0)
+	if filter == nil {
+		return contexts
+	}
+
+	for _, c := range filter.ContextList {
+		scopes := make([]map[string]interface{}, 0)
+		for _, s := range c.ScopeFilterExpressionList {
+			scopes = append(scopes, map[string]interface{}{
+				"left_hand_side":  utils.StringValue(s.LeftHandSide),
+				"operator":        utils.StringValue(s.Operator),
+				"right_hand_side": flattenRightHandSide(s.RightHandSide),
+			})
+		}
+
+		entities := make([]map[string]interface{}, 0)
+		for _, e := range c.EntityFilterExpressionList {
+			entityType := ""
+			if e.LeftHandSide != nil {
+				entityType = utils.StringValue(e.LeftHandSide.EntityType)
+			}
+			entities = append(entities, map[string]interface{}{
+				"left_hand_side_entity_type": entityType,
+				"operator":                   utils.StringValue(e.Operator),
+				"right_hand_side":            flattenRightHandSide(e.RightHandSide),
+			})
+		}
+
+		contexts = append(contexts, map[string]interface{}{
+			"scope_filter_expression_list":  scopes,
+			"entity_filter_expression_list": entities,
+		})
+	}
+
+	return contexts
+}
+
+func flattenRightHandSide(r *v3.RightHandSide) []map[string]interface{} {
+	if r == nil {
+		return make([]map[string]interface{}, 0)
+	}
+
+	// Category names come back as a map, sort them to keep the list stable.
+	names := make([]string, 0, len(r.Categories))
+	for name := range r.Categories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	categories := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		categories = append(categories, map[string]interface{}{
+			"name":  name,
+			"value": r.Categories[name],
+		})
+	}
+
+	uuids := r.UUIDList
+	if uuids == nil {
+		uuids = make([]string, 0)
+	}
+
+	return []map[string]interface{}{{
+		"collection": utils.StringValue(r.Collection),
+		"categories": categories,
+		"uuid_list":  uuids,
+	}}
+}
+
+func accessControlPolicyStateRefreshFunc(client *v3.Client, uuid string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := client.V3.GetAccessControlPolicy(uuid)
+
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+				return v, "DELETED", nil
+			}
+			log.Printf("ERROR %s", err)
+			return nil, "", err
+		}
+
+		if v.Status == nil {
+			return v, "PENDING", nil
+		}
+
+		return v, utils.StringValue(v.Status.State), nil
+	}
+}
+
+func getRightHandSideSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"collection": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateOneOf("ALL", "SELF_OWNED"),
+				},
+				"categories": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"value": {
+								Type:     schema.TypeList,
+								Required: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"uuid_list": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func getAccessControlPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_version": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"metadata": getComputedMetadataSchema(),
+		"categories": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"role_reference": {
+			Type:             schema.TypeMap,
+			Required:         true,
+			DiffSuppressFunc: suppressReferenceDiff,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"kind": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"uuid": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"user_reference_list":       getReferenceListSchema(),
+		"user_group_reference_list": getReferenceListSchema(),
+		"context_list": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"scope_filter_expression_list": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"left_hand_side": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validateOneOf("PROJECT", "CLUSTER", "CATEGORY"),
+								},
+								"operator": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"right_hand_side": getRightHandSideSchema(),
+							},
+						},
+					},
+					"entity_filter_expression_list": {
+						Type:     schema.TypeList,
+						Required: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"left_hand_side_entity_type": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"operator": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"right_hand_side": getRightHandSideSchema(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// findAccessControlPolicyByName returns the UUID of the only access control policy with the given name.
+func findAccessControlPolicyByName(conn *v3.Client, name string) (string, error) {
+	log.Printf("[DEBUG] Looking up Access Control Policy: %s", name)
+
+	var uuids []string
+	err := utils.ListAll(100, func(offset, length int64) (int, int64, error) {
+		resp, err := conn.V3.ListAccessControlPolicy(listPage("access_control_policy", fiqlEquals("name", name), offset, length))
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, acp := range resp.Entities {
+			if acp.Status != nil && utils.StringValue(acp.Status.Name) == name {
+				uuids = append(uuids, utils.StringValue(acp.Metadata.UUID))
+			}
+		}
+		return len(resp.Entities), listTotal(resp.Metadata), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return singleMatch("access_control_policy", name, uuids)
+}
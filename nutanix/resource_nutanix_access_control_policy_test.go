@@ -0,0 +1,149 @@
+package nutanix
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNutanixAccessControlPolicy_basic(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixAccessControlPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixAccessControlPolicyConfig(r, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixAccessControlPolicyExists("nutanix_access_control_policy.test"),
+					resource.TestCheckResourceAttrPair(
+						"nutanix_access_control_policy.test", "role_reference.uuid", "nutanix_role.test", "id"),
+					resource.TestCheckResourceAttr(
+						"nutanix_access_control_policy.test", "context_list.0.scope_filter_expression_list.0.left_hand_side", "CATEGORY"),
+					resource.TestCheckResourceAttr(
+						"nutanix_access_control_policy.test", "context_list.0.entity_filter_expression_list.0.right_hand_side.0.collection", "ALL"),
+				),
+			},
+			{
+				Config: testAccNutanixAccessControlPolicyConfig(r, "other"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"nutanix_access_control_policy.test", "role_reference.uuid", "nutanix_role.other", "id"),
+					resource.TestCheckResourceAttrPair(
+						"nutanix_access_control_policy.test", "role_reference.name", "nutanix_role.other", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNutanixAccessControlPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNutanixAccessControlPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*NutanixClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nutanix_access_control_policy" {
+			continue
+		}
+		for {
+			_, err := conn.API.V3.GetAccessControlPolicy(rs.Primary.ID)
+			if err != nil {
+				if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+					return nil
+				}
+				return err
+			}
+			time.Sleep(3000 * time.Millisecond)
+		}
+	}
+
+	return nil
+}
+
+func testAccNutanixAccessControlPolicyConfig(r int, role string) string {
+	return fmt.Sprintf(`
+resource "nutanix_role" "test" {
+  name = "acp-role-%[1]d"
+
+  permission_reference_list = [
+    {
+      name = "View_Virtual_Machine"
+    },
+  ]
+}
+
+resource "nutanix_role" "other" {
+  name = "acp-role-other-%[1]d"
+
+  permission_reference_list = [
+    {
+      name = "View_Virtual_Machine"
+    },
+  ]
+}
+
+resource "nutanix_access_control_policy" "test" {
+  name        = "acp-%[1]d"
+  description = "View production VMs"
+
+  role_reference = {
+    name = "${nutanix_role.%[2]s.name}"
+  }
+
+  context_list = [
+    {
+      scope_filter_expression_list = [
+        {
+          left_hand_side = "CATEGORY"
+          operator       = "IN"
+
+          right_hand_side = [
+            {
+              categories = [
+                {
+                  name  = "Environment"
+                  value = ["Production"]
+                },
+              ]
+            },
+          ]
+        },
+      ]
+
+      entity_filter_expression_list = [
+        {
+          left_hand_side_entity_type = "vm"
+          operator                   = "IN"
+
+          right_hand_side = [
+            {
+              collection = "ALL"
+            },
+          ]
+        },
+      ]
+    },
+  ]
+}
+`, r, role)
+}
@@ -0,0 +1,350 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// categoryAssignmentKinds are the entity kinds whose metadata.categories can be managed by nutanix_category_assignment.
+var categoryAssignmentKinds = []string{"vm", "image", "subnet"}
+
+// categoryAssignmentLookups resolve the name:<name> and <cluster>/<name> forms of the entity in an import ID.
+var categoryAssignmentLookups = map[string]nameLookupFunc{
+	"vm":     lookupVM,
+	"image":  lookupImage,
+	"subnet": lookupSubnet,
+}
+
+func resourceNutanixCategoryAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNutanixCategoryAssignmentCreate,
+		Read:   resourceNutanixCategoryAssignmentRead,
+		Update: resourceNutanixCategoryAssignmentUpdate,
+		Delete: resourceNutanixCategoryAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNutanixCategoryAssignmentImport,
+		},
+		Schema: getCategoryAssignmentSchema(),
+	}
+}
+
+func resourceNutanixCategoryAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	kind := d.Get("entity_kind").(string)
+	uuid := d.Get("entity_uuid").(string)
+	categories := expandCategoryAssignment(d.Get("categories").(map[string]interface{}))
+
+	log.Printf("[DEBUG] Assigning categories to %s %s", kind, uuid)
+
+	previous := make(map[string]interface{})
+	err := updateEntityCategories(conn, kind, uuid, func(current map[string]string) {
+		for k, v := range categories {
+			if p, ok := current[k]; ok {
+				previous[k] = p
+			}
+			current[k] = v
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", kind, uuid))
+	if err := d.Set("previous_categories", previous); err != nil {
+		return err
+	}
+
+	return resourceNutanixCategoryAssignmentRead(d, meta)
+}
+
+func resourceNutanixCategoryAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	kind := d.Get("entity_kind").(string)
+	uuid := d.Get("entity_uuid").(string)
+
+	log.Printf("[DEBUG] Reading categories assigned to %s %s", kind, uuid)
+
+	current, err := getEntityCategories(conn, kind, uuid)
+	if err != nil {
+		if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Only report the keys this resource manages, so categories set by other tooling never show up as drift.
+	categories := make(map[string]interface{})
+	for k := range d.Get("categories").(map[string]interface{}) {
+		if v, ok := current[k]; ok {
+			categories[k] = v
+		}
+	}
+
+	return d.Set("categories", categories)
+}
+
+func resourceNutanixCategoryAssignmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	kind := d.Get("entity_kind").(string)
+	uuid := d.Get("entity_uuid").(string)
+
+	if d.HasChange("categories") {
+		o, n := d.GetChange("categories")
+		old := expandCategoryAssignment(o.(map[string]interface{}))
+		categories := expandCategoryAssignment(n.(map[string]interface{}))
+		previous := d.Get("previous_categories").(map[string]interface{})
+
+		log.Printf("[DEBUG] Updating categories assigned to %s %s", kind, uuid)
+
+		err := updateEntityCategories(conn, kind, uuid, func(current map[string]string) {
+			for k, v := range old {
+				if _, ok := categories[k]; !ok {
+					restoreCategory(current, previous, k, v)
+					delete(previous, k)
+				}
+			}
+			for k, v := range categories {
+				if p, ok := current[k]; ok {
+					if _, managed := old[k]; !managed {
+						previous[k] = p
+					}
+				}
+				current[k] = v
+			}
+		})
+		if err != nil {
+			return err
+		}
+		if err := d.Set("previous_categories", previous); err != nil {
+			return err
+		}
+	}
+
+	return resourceNutanixCategoryAssignmentRead(d, meta)
+}
+
+func resourceNutanixCategoryAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	kind := d.Get("entity_kind").(string)
+	uuid := d.Get("entity_uuid").(string)
+	categories := expandCategoryAssignment(d.Get("categories").(map[string]interface{}))
+	previous := d.Get("previous_categories").(map[string]interface{})
+
+	log.Printf("[DEBUG] Removing categories assigned to %s %s", kind, uuid)
+
+	err := updateEntityCategories(conn, kind, uuid, func(current map[string]string) {
+		for k, v := range categories {
+			restoreCategory(current, previous, k, v)
+		}
+	})
+	if err != nil && !strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceNutanixCategoryAssignmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("expected import ID in the form <entity_kind>/<entity_uuid>, got %q", d.Id())
+	}
+
+	conn := meta.(*NutanixClient).API
+
+	uuid := parts[1]
+	if lookup, ok := categoryAssignmentLookups[parts[0]]; ok {
+		var err error
+		if uuid, err = resolveImportID(conn, uuid, lookup); err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := getEntityCategories(conn, parts[0], uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without configuration there is no way to tell which categories were added by Terraform, so the import
+	// adopts all of them and records their values as the previous ones. Keys dropped from the configuration
+	// later, or on destroy, keep the value they had when they were imported.
+	categories := make(map[string]interface{})
+	for k, v := range current {
+		categories[k] = v
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", parts[0], uuid))
+	d.Set("entity_kind", parts[0])
+	d.Set("entity_uuid", uuid)
+	d.Set("categories", categories)
+	d.Set("previous_categories", categories)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// restoreCategory gives a key back the value it had before the resource assigned it, or removes the key when the
+// resource added it. A key that was changed by someone else since is left alone.
+func restoreCategory(current map[string]string, previous map[string]interface{}, k, v string) {
+	if current[k] != v {
+		return
+	}
+	if p, ok := previous[k]; ok {
+		current[k] = p.(string)
+		return
+	}
+	delete(current, k)
+}
+
+func expandCategoryAssignment(m map[string]interface{}) map[string]string {
+	categories := make(map[string]string)
+	for k, v := range m {
+		categories[k] = v.(string)
+	}
+	return categories
+}
+
+// getEntityCategories returns the metadata.categories of the given entity.
+func getEntityCategories(conn *v3.Client, kind, uuid string) (map[string]string, error) {
+	switch kind {
+	case "vm":
+		resp, err := conn.V3.GetVM(uuid)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Metadata.Categories, nil
+	case "image":
+		resp, err := conn.V3.GetImage(uuid)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Metadata.Categories, nil
+	case "subnet":
+		resp, err := conn.V3.GetSubnet(uuid)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Metadata.Categories, nil
+	}
+	return nil, fmt.Errorf("entity kind %q does not support category assignment", kind)
+}
+
+// updateEntityCategories reads the given entity, lets mutate change its categories and writes back the full spec,
+// so that every other field of the entity is preserved. It waits until the entity is COMPLETE again.
+func updateEntityCategories(conn *v3.Client, kind, uuid string, mutate func(map[string]string)) error {
+	var refresh resource.StateRefreshFunc
+
+	switch kind {
+	case "vm":
+		resp, err := conn.V3.GetVM(uuid)
+		if err != nil {
+			return err
+		}
+		if resp.Metadata.Categories == nil {
+			resp.Metadata.Categories = make(map[string]string)
+		}
+		mutate(resp.Metadata.Categories)
+
+		request := &v3.VMIntentInput{
+			APIVersion: resp.APIVersion,
+			Metadata:   resp.Metadata,
+			Spec:       resp.Spec,
+		}
+		if _, err := conn.V3.UpdateVM(uuid, request); err != nil {
+			return err
+		}
+		refresh = vmStateRefreshFunc(conn, uuid)
+	case "image":
+		resp, err := conn.V3.GetImage(uuid)
+		if err != nil {
+			return err
+		}
+		if resp.Metadata.Categories == nil {
+			resp.Metadata.Categories = make(map[string]string)
+		}
+		mutate(resp.Metadata.Categories)
+
+		request := &v3.ImageIntentInput{
+			APIVersion: resp.APIVersion,
+			Metadata:   resp.Metadata,
+			Spec:       resp.Spec,
+		}
+		if _, err := conn.V3.UpdateImage(uuid, request); err != nil {
+			return err
+		}
+		refresh = imageStateRefreshFunc(conn, uuid)
+	case "subnet":
+		resp, err := conn.V3.GetSubnet(uuid)
+		if err != nil {
+			return err
+		}
+		if resp.Metadata.Categories == nil {
+			resp.Metadata.Categories = make(map[string]string)
+		}
+		mutate(resp.Metadata.Categories)
+
+		request := &v3.SubnetIntentInput{
+			APIVersion: resp.APIVersion,
+			Metadata:   resp.Metadata,
+			Spec:       resp.Spec,
+		}
+		if _, err := conn.V3.UpdateSubnet(uuid, request); err != nil {
+			return err
+		}
+		refresh = subnetStateRefreshFunc(conn, uuid)
+	default:
+		return fmt.Errorf("entity kind %q does not support category assignment", kind)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    refresh,
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for %s (%s) to update categories: %s", kind, uuid, err)
+	}
+
+	return nil
+}
+
+func getCategoryAssignmentSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"entity_kind": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateOneOf(categoryAssignmentKinds...),
+		},
+		"entity_uuid": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"categories": {
+			Type:     schema.TypeMap,
+			Required: true,
+		},
+		"previous_categories": {
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+	}
+}
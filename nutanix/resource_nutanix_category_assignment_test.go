@@ -0,0 +1,217 @@
+package nutanix
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNutanixCategoryAssignment_basic(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixCategoryAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixCategoryAssignmentConfig(r, "Production"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixCategoryAssignmentExists("nutanix_category_assignment.test"),
+					resource.TestCheckResourceAttr("nutanix_category_assignment.test", "entity_kind", "image"),
+					resource.TestCheckResourceAttr("nutanix_category_assignment.test", "categories.%", "1"),
+					resource.TestCheckResourceAttr("nutanix_category_assignment.test", "categories.Environment", "Production"),
+				),
+			},
+			{
+				Config: testAccNutanixCategoryAssignmentConfig(r, "Staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixCategoryAssignmentExists("nutanix_category_assignment.test"),
+					resource.TestCheckResourceAttr("nutanix_category_assignment.test", "categories.Environment", "Staging"),
+				),
+			},
+			{
+				ResourceName:     "nutanix_category_assignment.test",
+				ImportState:      true,
+				ImportStateCheck: testAccCheckNutanixCategoryAssignmentImported("Environment", "Staging"),
+			},
+		},
+	})
+}
+
+// testAccCheckNutanixCategoryAssignmentImported checks that an imported category is kept as its own previous
+// value, so removing it from the configuration later leaves it on the entity.
+func testAccCheckNutanixCategoryAssignmentImported(key, value string) resource.ImportStateCheckFunc {
+	return func(s []*terraform.InstanceState) error {
+		if len(s) != 1 {
+			return fmt.Errorf("expected 1 imported category assignment, got %d", len(s))
+		}
+		attrs := s[0].Attributes
+		if attrs["categories."+key] != value || attrs["previous_categories."+key] != value {
+			return fmt.Errorf("expected category %s=%s imported with the same previous value, got %q and %q",
+				key, value, attrs["categories."+key], attrs["previous_categories."+key])
+		}
+
+		return nil
+	}
+}
+
+func TestAccNutanixCategoryAssignment_restoresPrevious(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixCategoryAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixCategoryAssignmentConfigPrevious(r, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixCategoryAssignmentExists("nutanix_category_assignment.test"),
+					resource.TestCheckResourceAttr("nutanix_category_assignment.test", "categories.Environment", "Production"),
+					resource.TestCheckResourceAttr("nutanix_category_assignment.test", "previous_categories.Environment", "Staging"),
+				),
+			},
+			{
+				Config: testAccNutanixCategoryAssignmentConfigPrevious(r, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixImageCategory("nutanix_image.test", "Environment", "Staging"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNutanixImageCategory(n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*NutanixClient)
+
+		categories, err := getEntityCategories(conn.API, "image", rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if categories[key] != value {
+			return fmt.Errorf("category %s of %s is %q, expected %q", key, n, categories[key], value)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNutanixCategoryAssignmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*NutanixClient)
+
+		categories, err := getEntityCategories(conn.API, rs.Primary.Attributes["entity_kind"], rs.Primary.Attributes["entity_uuid"])
+		if err != nil {
+			return err
+		}
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "categories.") && k != "categories.%" {
+				if categories[strings.TrimPrefix(k, "categories.")] != v {
+					return fmt.Errorf("category %s is not assigned", k)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNutanixCategoryAssignmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*NutanixClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nutanix_category_assignment" {
+			continue
+		}
+
+		categories, err := getEntityCategories(conn.API, rs.Primary.Attributes["entity_kind"], rs.Primary.Attributes["entity_uuid"])
+		if err != nil {
+			// The assigned entity is destroyed in the same configuration.
+			continue
+		}
+		if categories["Environment"] == rs.Primary.Attributes["categories.Environment"] &&
+			categories["Environment"] != rs.Primary.Attributes["previous_categories.Environment"] {
+			return fmt.Errorf("category Environment is still assigned to %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccNutanixCategoryAssignmentConfig(r int, value string) string {
+	return fmt.Sprintf(`
+resource "nutanix_image" "test" {
+  name        = "Ubuntu-%d"
+  description = "Ubuntu"
+  source_uri  = "http://archive.ubuntu.com/ubuntu/dists/bionic/main/installer-amd64/current/images/netboot/mini.iso"
+
+  metadata = {
+    kind = "image"
+  }
+}
+
+resource "nutanix_category_assignment" "test" {
+  entity_kind = "image"
+  entity_uuid = "${nutanix_image.test.id}"
+
+  categories = {
+    Environment = "%s"
+  }
+}
+`, r, value)
+}
+
+func testAccNutanixCategoryAssignmentConfigPrevious(r int, assigned bool) string {
+	assignment := ""
+	if assigned {
+		assignment = `
+resource "nutanix_category_assignment" "test" {
+  entity_kind = "image"
+  entity_uuid = "${nutanix_image.test.id}"
+
+  categories = {
+    Environment = "Production"
+  }
+}
+`
+	}
+
+	return fmt.Sprintf(`
+resource "nutanix_image" "test" {
+  name        = "Ubuntu-%d"
+  description = "Ubuntu"
+  source_uri  = "http://archive.ubuntu.com/ubuntu/dists/bionic/main/installer-amd64/current/images/netboot/mini.iso"
+
+  metadata = {
+    kind = "image"
+  }
+
+  categories = {
+    Environment = "Staging"
+  }
+
+  lifecycle {
+    ignore_changes = ["categories"]
+  }
+}
+%s`, r, assignment)
+}
@@ -0,0 +1,416 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// resourceNutanixClusterConfig manages the network settings of an existing cluster. The cluster itself is
+// never created or deleted: create adopts the cluster and delete only removes it from the state. Settings
+// left out of the configuration are kept as they are on the cluster.
+func resourceNutanixClusterConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNutanixClusterConfigCreate,
+		Read:   resourceNutanixClusterConfigRead,
+		Update: resourceNutanixClusterConfigUpdate,
+		Delete: resourceNutanixClusterConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				uuid, err := resolveImportID(meta.(*NutanixClient).API, d.Id(),
+					unscopedLookup("cluster", findClusterByName))
+				if err != nil {
+					return nil, err
+				}
+				d.SetId(uuid)
+				d.Set("cluster_id", uuid)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: getClusterConfigSchema(),
+	}
+}
+
+func resourceNutanixClusterConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("cluster_id").(string))
+
+	log.Printf("[DEBUG] Adopting configuration of Cluster: %s", d.Id())
+
+	return resourceNutanixClusterConfigUpdate(d, meta)
+}
+
+func resourceNutanixClusterConfigRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Reading configuration of Cluster: %s", d.Id())
+
+	conn := meta.(*NutanixClient).API
+
+	resp, err := conn.V3.GetCluster(d.Id())
+	if err != nil {
+		if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if err := d.Set("cluster_id", d.Id()); err != nil {
+		return err
+	}
+	if err := d.Set("api_version", utils.StringValue(resp.APIVersion)); err != nil {
+		return err
+	}
+	if err := d.Set("spec_version", int(utils.Int64Value(resp.Metadata.SpecVersion))); err != nil {
+		return err
+	}
+
+	network := &v3.ClusterNetwork{}
+	if resp.Status != nil && resp.Status.Resources != nil && resp.Status.Resources.Network != nil {
+		network = resp.Status.Resources.Network
+	}
+
+	if err := d.Set("ntp_server_ip_list", utils.StringValueSlice(network.NTPServerIPList)); err != nil {
+		return err
+	}
+	if err := d.Set("name_server_ip_list", utils.StringValueSlice(network.NameServerIPList)); err != nil {
+		return err
+	}
+	if err := d.Set("external_ip", utils.StringValue(network.ExternalIP)); err != nil {
+		return err
+	}
+	if err := d.Set("external_data_services_ip", utils.StringValue(network.ExternalDataServicesIP)); err != nil {
+		return err
+	}
+
+	// Passwords are never returned by the API, keep the configured ones so they don't show up as drift.
+	smtp := make([]map[string]interface{}, 0)
+	if network.SMTPServer != nil && network.SMTPServer.Server != nil {
+		s := flattenClusterNetworkEntity(network.SMTPServer.Server)
+		s["type"] = utils.StringValue(network.SMTPServer.Type)
+		s["email_address"] = utils.StringValue(network.SMTPServer.EmailAddress)
+		s["password"] = d.Get("smtp_server.0.password").(string)
+		delete(s, "proxy_type_list")
+		smtp = append(smtp, s)
+	}
+	if err := d.Set("smtp_server", smtp); err != nil {
+		return err
+	}
+
+	proxies := make([]map[string]interface{}, len(network.HTTPProxyList))
+	for k, p := range network.HTTPProxyList {
+		proxies[k] = flattenClusterNetworkEntity(p)
+		proxies[k]["password"] = d.Get(fmt.Sprintf("http_proxy.%d.password", k)).(string)
+	}
+	if err := d.Set("http_proxy", proxies); err != nil {
+		return err
+	}
+
+	whitelist := make([]map[string]interface{}, len(network.HTTPProxyWhitelist))
+	for k, w := range network.HTTPProxyWhitelist {
+		whitelist[k] = map[string]interface{}{
+			"target":      utils.StringValue(w.Target),
+			"target_type": utils.StringValue(w.TargetType),
+		}
+	}
+
+	return d.Set("http_proxy_whitelist", whitelist)
+}
+
+func resourceNutanixClusterConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	log.Printf("[DEBUG] Updating configuration of Cluster: %s", d.Id())
+
+	// Read the current spec so that only the managed settings change and the spec_version is current.
+	resp, err := conn.V3.GetCluster(d.Id())
+	if err != nil {
+		return err
+	}
+	if resp.Spec == nil {
+		return fmt.Errorf("cluster (%s) returned no spec to update", d.Id())
+	}
+
+	spec := resp.Spec
+	if spec.Resources == nil {
+		spec.Resources = &v3.ClusterResource{}
+	}
+	if spec.Resources.Network == nil {
+		spec.Resources.Network = &v3.ClusterNetwork{}
+	}
+	network := spec.Resources.Network
+
+	if v, ok := d.GetOk("ntp_server_ip_list"); ok {
+		network.NTPServerIPList = expandStringPtrList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("name_server_ip_list"); ok {
+		network.NameServerIPList = expandStringPtrList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("external_ip"); ok {
+		network.ExternalIP = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("external_data_services_ip"); ok {
+		network.ExternalDataServicesIP = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("smtp_server"); ok {
+		s := v.([]interface{})[0].(map[string]interface{})
+		network.SMTPServer = &v3.SMTPServer{
+			Type:         utils.String(s["type"].(string)),
+			EmailAddress: utils.String(s["email_address"].(string)),
+			Server:       expandClusterNetworkEntity(s),
+		}
+	}
+	if v, ok := d.GetOk("http_proxy"); ok {
+		network.HTTPProxyList = nil
+		for _, p := range v.([]interface{}) {
+			network.HTTPProxyList = append(network.HTTPProxyList, expandClusterNetworkEntity(p.(map[string]interface{})))
+		}
+	}
+	if v, ok := d.GetOk("http_proxy_whitelist"); ok {
+		network.HTTPProxyWhitelist = nil
+		for _, w := range v.([]interface{}) {
+			m := w.(map[string]interface{})
+			network.HTTPProxyWhitelist = append(network.HTTPProxyWhitelist, &v3.HTTPProxyWhitelist{
+				Target:     utils.String(m["target"].(string)),
+				TargetType: utils.String(m["target_type"].(string)),
+			})
+		}
+	}
+
+	request := &v3.ClusterIntentInput{
+		APIVersion: resp.APIVersion,
+		Metadata:   resp.Metadata,
+		Spec:       spec,
+	}
+
+	if _, err := conn.V3.UpdateCluster(d.Id(), request); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    clusterStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for cluster (%s) to update: %s", d.Id(), err)
+	}
+
+	return resourceNutanixClusterConfigRead(d, meta)
+}
+
+func resourceNutanixClusterConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing configuration of Cluster %s from state, the cluster settings are left as they are", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func expandStringPtrList(list []interface{}) []*string {
+	s := make([]*string, len(list))
+	for k, v := range list {
+		s[k] = utils.String(v.(string))
+	}
+	return s
+}
+
+func expandClusterNetworkEntity(m map[string]interface{}) *v3.ClusterNetworkEntity {
+	e := &v3.ClusterNetworkEntity{
+		Address: &v3.Address{},
+	}
+
+	if v, ok := m["ip"]; ok && v.(string) != "" {
+		e.Address.IP = utils.String(v.(string))
+	}
+	if v, ok := m["fqdn"]; ok && v.(string) != "" {
+		e.Address.FQDN = utils.String(v.(string))
+	}
+	if v, ok := m["port"]; ok && v.(int) != 0 {
+		e.Address.Port = utils.Int64(int64(v.(int)))
+	}
+	if v, ok := m["username"]; ok && v.(string) != "" {
+		e.Credentials = &v3.Credentials{
+			Username: utils.String(v.(string)),
+			Password: utils.String(m["password"].(string)),
+		}
+	}
+	if v, ok := m["proxy_type_list"]; ok {
+		e.ProxyTypeList = expandStringPtrList(v.([]interface{}))
+	}
+
+	return e
+}
+
+func flattenClusterNetworkEntity(e *v3.ClusterNetworkEntity) map[string]interface{} {
+	m := map[string]interface{}{
+		"ip":              "",
+		"fqdn":            "",
+		"port":            0,
+		"username":        "",
+		"proxy_type_list": utils.StringValueSlice(e.ProxyTypeList),
+	}
+
+	if e.Address != nil {
+		m["ip"] = utils.StringValue(e.Address.IP)
+		m["fqdn"] = utils.StringValue(e.Address.FQDN)
+		m["port"] = int(utils.Int64Value(e.Address.Port))
+	}
+	if e.Credentials != nil {
+		m["username"] = utils.StringValue(e.Credentials.Username)
+	}
+
+	return m
+}
+
+func clusterStateRefreshFunc(client *v3.Client, uuid string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := client.V3.GetCluster(uuid)
+
+		if err != nil {
+			log.Printf("ERROR %s", err)
+			return nil, "", err
+		}
+
+		if v.Status == nil {
+			return v, "PENDING", nil
+		}
+
+		return v, utils.StringValue(v.Status.State), nil
+	}
+}
+
+func getClusterNetworkEntitySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ip": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"fqdn": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"port": {
+			Type:     schema.TypeInt,
+			Required: true,
+			ValidateFunc: func(v interface{}, k string) (ws []string, es []error) {
+				if p := v.(int); p < 1 || p > 65535 {
+					es = append(es, fmt.Errorf("%q must be a port number, got: %s", k, strconv.Itoa(p)))
+				}
+				return
+			},
+		},
+		"username": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func getClusterConfigSchema() map[string]*schema.Schema {
+	smtp := getClusterNetworkEntitySchema()
+	smtp["type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "PLAIN",
+		ValidateFunc: validateOneOf("PLAIN", "STARTTLS", "SSL"),
+	}
+	smtp["email_address"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	proxy := getClusterNetworkEntitySchema()
+	proxy["proxy_type_list"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return map[string]*schema.Schema{
+		"cluster_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"api_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"spec_version": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"ntp_server_ip_list": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"name_server_ip_list": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"external_ip": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"external_data_services_ip": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"smtp_server": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: smtp,
+			},
+		},
+		"http_proxy": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: proxy,
+			},
+		},
+		"http_proxy_whitelist": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"target": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"target_type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateOneOf("IPV4_ADDRESS", "IPV4_NETWORK_MASK", "DOMAIN_NAME_SUFFIX", "HOST_NAME"),
+					},
+				},
+			},
+		},
+	}
+}
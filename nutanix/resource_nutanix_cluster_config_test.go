@@ -0,0 +1,70 @@
+package nutanix
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNutanixClusterConfig_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixClusterConfigConfig("0.pool.ntp.org", "8.8.8.8"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixClusterConfigExists("nutanix_cluster_config.test"),
+					resource.TestCheckResourceAttr("nutanix_cluster_config.test", "ntp_server_ip_list.0", "0.pool.ntp.org"),
+					resource.TestCheckResourceAttr("nutanix_cluster_config.test", "name_server_ip_list.0", "8.8.8.8"),
+					resource.TestCheckResourceAttr("nutanix_cluster_config.test", "http_proxy_whitelist.#", "1"),
+				),
+			},
+			{
+				Config: testAccNutanixClusterConfigConfig("1.pool.ntp.org", "8.8.4.4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_cluster_config.test", "ntp_server_ip_list.0", "1.pool.ntp.org"),
+					resource.TestCheckResourceAttr("nutanix_cluster_config.test", "name_server_ip_list.0", "8.8.4.4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNutanixClusterConfigExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccNutanixClusterConfigConfig(ntp, dns string) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_cluster_config" "test" {
+  cluster_id = "${var.clusterid}"
+
+  ntp_server_ip_list  = ["%s"]
+  name_server_ip_list = ["%s"]
+
+  http_proxy_whitelist = [
+    {
+      target      = "nutanix.com"
+      target_type = "DOMAIN_NAME_SUFFIX"
+    },
+  ]
+}
+`, ntp, dns)
+}
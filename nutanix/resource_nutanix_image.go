@@ -22,14 +22,12 @@ const (
 
 func resourceNutanixImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNutanixImageCreate,
-		Read:   resourceNutanixImageRead,
-		Update: resourceNutanixImageUpdate,
-		Delete: resourceNutanixImageDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Schema: getImageSchema(),
+		Create:   resourceNutanixImageCreate,
+		Read:     resourceNutanixImageRead,
+		Update:   resourceNutanixImageUpdate,
+		Delete:   resourceNutanixImageDelete,
+		Importer: importStateByName(lookupImage),
+		Schema:   getImageSchema(),
 	}
 }
 
@@ -55,7 +53,10 @@ func resourceNutanixImageCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Please provide the required attribute name")
 	}
 
-	if err := getImageMetadaAttributes(d, metadata); err != nil {
+	if err := getImageMetadaAttributes(d, metadata, meta.(*NutanixClient).DefaultCategories); err != nil {
+		return err
+	}
+	if err := resolveProjectReference(conn, metadata.ProjectReference); err != nil {
 		return err
 	}
 
@@ -135,7 +136,15 @@ func resourceNutanixImageRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("metadata", metadata); err != nil {
 		return err
 	}
-	if err := d.Set("categories", resp.Metadata.Categories); err != nil {
+	categories := flattenCategories(meta.(*NutanixClient).DefaultCategories, resp.Metadata.Categories, d.Get("categories").(map[string]interface{}))
+	if err := d.Set("categories", categories); err != nil {
+		return err
+	}
+	pr := make(map[string]interface{})
+	if resp.Metadata.ProjectReference != nil {
+		pr = flattenReference(resp.Metadata.ProjectReference)
+	}
+	if err := d.Set("project_reference", pr); err != nil {
 		return err
 	}
 
@@ -226,7 +235,10 @@ func resourceNutanixImageUpdate(d *schema.ResourceData, meta interface{}) error
 		d.HasChange("categories") ||
 		d.HasChange("owner_reference") ||
 		d.HasChange("project_reference") {
-		if err := getImageMetadaAttributes(d, metadata); err != nil {
+		if err := getImageMetadaAttributes(d, metadata, meta.(*NutanixClient).DefaultCategories); err != nil {
+			return err
+		}
+		if err := resolveProjectReference(conn, metadata.ProjectReference); err != nil {
 			return err
 		}
 		request.Metadata = metadata
@@ -371,8 +383,10 @@ func getImageSchema() map[string]*schema.Schema {
 			},
 		},
 		"project_reference": {
-			Type:     schema.TypeMap,
-			Optional: true,
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressReferenceDiff,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"kind": {
@@ -528,7 +542,7 @@ func getImageSchema() map[string]*schema.Schema {
 	}
 }
 
-func getImageMetadaAttributes(d *schema.ResourceData, metadata *v3.ImageMetadata) error {
+func getImageMetadaAttributes(d *schema.ResourceData, metadata *v3.ImageMetadata, defaults map[string]string) error {
 	m, mok := d.GetOk("metadata")
 	metad := m.(map[string]interface{})
 
@@ -554,28 +568,12 @@ func getImageMetadaAttributes(d *schema.ResourceData, metadata *v3.ImageMetadata
 	if v, ok := metad["name"]; ok {
 		metadata.Name = utils.String(v.(string))
 	}
-	if v, ok := d.GetOk("categories"); ok {
-		p := v.([]interface{})
-		if len(p) > 0 {
-			c := p[0].(map[string]interface{})
-			labels := map[string]string{}
-
-			for k, v := range c {
-				labels[k] = v.(string)
-			}
-			metadata.Categories = labels
-		}
+	if v, ok := d.GetOk("categories"); ok || len(defaults) > 0 {
+		c, _ := v.(map[string]interface{})
+		metadata.Categories = mergeDefaultCategories(defaults, c)
 	}
 	if p, ok := d.GetOk("project_reference"); ok {
-		pr := p.(map[string]interface{})
-		r := &v3.Reference{
-			Kind: utils.String(pr["kind"].(string)),
-			UUID: utils.String(pr["uuid"].(string)),
-		}
-		if v1, ok1 := pr["name"]; ok1 {
-			r.Name = utils.String(v1.(string))
-		}
-		metadata.ProjectReference = r
+		metadata.ProjectReference = expandReference(p.(map[string]interface{}), "project")
 	}
 	if o, ok := metad["owner_reference"]; ok {
 		or := o.(map[string]interface{})
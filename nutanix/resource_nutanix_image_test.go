@@ -3,6 +3,7 @@ package nutanix
 import (
 	"fmt"
 	"math/rand"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -28,6 +29,70 @@ func TestAccNutanixImage_basic(t *testing.T) {
 	})
 }
 
+func TestAccNutanixImage_defaultCategories(t *testing.T) {
+	r := rand.Int31()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixImageConfigWithDefaultCategories(r),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixImageExists("nutanix_image.test"),
+					resource.TestCheckResourceAttr("nutanix_image.test", "categories.%", "1"),
+					resource.TestCheckResourceAttr("nutanix_image.test", "categories.Environment", "Staging"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNutanixImage_importByName(t *testing.T) {
+	r := rand.Int31()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixImageConfigName(r),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixImageExists("nutanix_image.test"),
+				),
+			},
+			{
+				ResourceName:     "nutanix_image.test",
+				ImportState:      true,
+				ImportStateId:    fmt.Sprintf("name:Ubuntu-import-%d", r),
+				ImportStateCheck: testAccCheckNutanixImageImported(fmt.Sprintf("Ubuntu-import-%d", r)),
+			},
+			{
+				ResourceName:  "nutanix_image.test",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("name:Ubuntu-missing-%d", r),
+				ExpectError:   regexp.MustCompile("no image found with name"),
+			},
+		},
+	})
+}
+
+func testAccCheckNutanixImageImported(name string) resource.ImportStateCheckFunc {
+	return func(s []*terraform.InstanceState) error {
+		if len(s) != 1 {
+			return fmt.Errorf("expected 1 imported image, got %d", len(s))
+		}
+		if s[0].Attributes["name"] != name {
+			return fmt.Errorf("expected imported image %s, got %s", name, s[0].Attributes["name"])
+		}
+		if !uuidRegexp.MatchString(s[0].ID) {
+			return fmt.Errorf("expected the image UUID as ID, got %s", s[0].ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckNutanixImageExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -79,3 +144,42 @@ resource "nutanix_image" "test" {
 }
 `)
 }
+
+func testAccNutanixImageConfigWithDefaultCategories(r int32) string {
+	return fmt.Sprintf(`
+provider "nutanix" {
+  default_categories = {
+    Owner       = "terraform"
+    Environment = "Production"
+  }
+}
+
+resource "nutanix_image" "test" {
+  name        = "Ubuntu-%d"
+  description = "Ubuntu"
+  source_uri  = "http://archive.ubuntu.com/ubuntu/dists/bionic/main/installer-amd64/current/images/netboot/mini.iso"
+
+  metadata = {
+    kind = "image"
+  }
+
+  categories = {
+    Environment = "Staging"
+  }
+}
+`, r)
+}
+
+func testAccNutanixImageConfigName(r int32) string {
+	return fmt.Sprintf(`
+resource "nutanix_image" "test" {
+  name        = "Ubuntu-import-%d"
+  description = "Ubuntu"
+  source_uri  = "http://archive.ubuntu.com/ubuntu/dists/bionic/main/installer-amd64/current/images/netboot/mini.iso"
+
+  metadata = {
+    kind = "image"
+  }
+}
+`, r)
+}
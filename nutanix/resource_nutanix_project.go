@@ -0,0 +1,402 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// projectResourceTypes are the resource types a project quota can be set on.
+var projectResourceTypes = []string{"VCPUS", "MEMORY", "STORAGE"}
+
+func resourceNutanixProject() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNutanixProjectCreate,
+		Read:     resourceNutanixProjectRead,
+		Update:   resourceNutanixProjectUpdate,
+		Delete:   resourceNutanixProjectDelete,
+		Importer: importStateByName(unscopedLookup("project", findProjectByName)),
+		Schema:   getProjectSchema(),
+	}
+}
+
+func resourceNutanixProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Creating Project: %s", d.Get("name").(string))
+
+	conn := meta.(*NutanixClient).API
+
+	request := &v3.ProjectInput{
+		Metadata: &v3.Metadata{
+			Kind: utils.String("project"),
+		},
+		Spec: getProjectSpec(d),
+	}
+
+	if v, ok := d.GetOk("api_version"); ok {
+		request.APIVersion = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("categories"); ok {
+		request.Metadata.Categories = expandCategoryAssignment(v.(map[string]interface{}))
+	}
+
+	utils.PrintToJSON(request, "CREATE METHOD REQUEST")
+
+	resp, err := conn.V3.CreateProject(request)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(utils.StringValue(resp.Metadata.UUID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    projectStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for project (%s) to create: %s", d.Id(), err)
+	}
+
+	return resourceNutanixProjectRead(d, meta)
+}
+
+func resourceNutanixProjectRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Reading Project: %s", d.Id())
+
+	conn := meta.(*NutanixClient).API
+
+	resp, err := conn.V3.GetProject(d.Id())
+	if err != nil {
+		if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return setProjectAttributes(d, resp)
+}
+
+func resourceNutanixProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	log.Printf("[DEBUG] Updating Project: %s", d.Id())
+
+	// Projects are only updated when the current spec_version is sent back.
+	resp, err := conn.V3.GetProject(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := &v3.ProjectInput{
+		APIVersion: resp.APIVersion,
+		Metadata:   resp.Metadata,
+		Spec:       getProjectSpec(d),
+	}
+
+	if d.HasChange("categories") {
+		request.Metadata.Categories = expandCategoryAssignment(d.Get("categories").(map[string]interface{}))
+	}
+
+	utils.PrintToJSON(request, "UPDATE METHOD REQUEST")
+
+	if _, err := conn.V3.UpdateProject(d.Id(), request); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    projectStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for project (%s) to update: %s", d.Id(), err)
+	}
+
+	return resourceNutanixProjectRead(d, meta)
+}
+
+func resourceNutanixProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	log.Printf("[DEBUG] Deleting Project: %s", d.Id())
+
+	if err := conn.V3.DeleteProject(d.Id()); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING", "DELETE_IN_PROGRESS", "COMPLETE"},
+		Target:     []string{"DELETED"},
+		Refresh:    projectStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for project (%s) to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getProjectSpec(d *schema.ResourceData) *v3.Project {
+	spec := &v3.Project{
+		Name:      utils.String(d.Get("name").(string)),
+		Resources: &v3.ProjectResources{},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		spec.Description = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("default_subnet_reference"); ok {
+		spec.Resources.DefaultSubnetReference = expandReference(v.(map[string]interface{}), "subnet")
+	}
+
+	spec.Resources.SubnetReferenceList = expandReferenceList(d.Get("subnet_reference_list").([]interface{}), "subnet")
+	spec.Resources.UserReferenceList = expandReferenceList(d.Get("user_reference_list").([]interface{}), "user")
+	spec.Resources.ExternalUserGroupReferenceList = expandReferenceList(
+		d.Get("external_user_group_reference_list").([]interface{}), "user_group")
+
+	quotas := d.Get("resource_domain").([]interface{})
+	if len(quotas) > 0 {
+		rd := &v3.ResourceDomain{
+			Resources: make([]*v3.ResourceDomainResource, len(quotas)),
+		}
+		for k, val := range quotas {
+			v := val.(map[string]interface{})
+			rd.Resources[k] = &v3.ResourceDomainResource{
+				ResourceType: utils.String(v["resource_type"].(string)),
+				Limit:        utils.Int64(int64(v["limit"].(int))),
+			}
+		}
+		spec.Resources.ResourceDomain = rd
+	}
+
+	return spec
+}
+
+func setProjectAttributes(d *schema.ResourceData, resp *v3.ProjectResponse) error {
+	if err := d.Set("metadata", flattenMetadata(resp.Metadata)); err != nil {
+		return err
+	}
+	if err := d.Set("categories", resp.Metadata.Categories); err != nil {
+		return err
+	}
+	if err := d.Set("api_version", utils.StringValue(resp.APIVersion)); err != nil {
+		return err
+	}
+
+	status := resp.Status
+	if status == nil {
+		status = &v3.ProjectStatus{}
+	}
+	if err := d.Set("name", utils.StringValue(status.Name)); err != nil {
+		return err
+	}
+	if err := d.Set("description", utils.StringValue(status.Description)); err != nil {
+		return err
+	}
+	if err := d.Set("state", utils.StringValue(status.State)); err != nil {
+		return err
+	}
+
+	res := status.Resources
+	if res == nil {
+		res = &v3.ProjectResources{}
+	}
+
+	dsr := make(map[string]interface{})
+	if res.DefaultSubnetReference != nil {
+		dsr = flattenReference(res.DefaultSubnetReference)
+	}
+	if err := d.Set("default_subnet_reference", dsr); err != nil {
+		return err
+	}
+	if err := d.Set("subnet_reference_list", flattenReferenceList(res.SubnetReferenceList)); err != nil {
+		return err
+	}
+	if err := d.Set("user_reference_list", flattenReferenceList(res.UserReferenceList)); err != nil {
+		return err
+	}
+	if err := d.Set("external_user_group_reference_list", flattenReferenceList(res.ExternalUserGroupReferenceList)); err != nil {
+		return err
+	}
+
+	quotas := make([]map[string]interface{}, 0)
+	if res.ResourceDomain != nil {
+		for _, r := range res.ResourceDomain.Resources {
+			quotas = append(quotas, map[string]interface{}{
+				"resource_type": utils.StringValue(r.ResourceType),
+				"limit":         utils.Int64Value(r.Limit),
+				"units":         utils.StringValue(r.Units),
+				"value":         utils.Int64Value(r.Value),
+			})
+		}
+	}
+
+	return d.Set("resource_domain", quotas)
+}
+
+// findProjectByName returns the UUID of the only project with the given name.
+func findProjectByName(conn *v3.Client, name string) (string, error) {
+	log.Printf("[DEBUG] Looking up Project: %s", name)
+
+	var uuids []string
+	err := utils.ListAll(100, func(offset, length int64) (int, int64, error) {
+		resp, err := conn.V3.ListProject(listPage("project", fiqlEquals("name", name), offset, length))
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, p := range resp.Entities {
+			// The filter is a prefix match on some Prism versions.
+			if p.Spec != nil && utils.StringValue(p.Spec.Name) == name {
+				uuids = append(uuids, utils.StringValue(p.Metadata.UUID))
+			}
+		}
+		return len(resp.Entities), listTotal(resp.Metadata), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return singleMatch("project", name, uuids)
+}
+
+// resolveProjectReference fills in the uuid of a project reference given only by name.
+func resolveProjectReference(conn *v3.Client, r *v3.Reference) error {
+	if r == nil || r.UUID != nil {
+		return nil
+	}
+	if r.Name == nil {
+		return fmt.Errorf("project_reference requires either uuid or name")
+	}
+	uuid, err := findProjectByName(conn, utils.StringValue(r.Name))
+	if err != nil {
+		return err
+	}
+	r.UUID = utils.String(uuid)
+	return nil
+}
+
+func projectStateRefreshFunc(client *v3.Client, uuid string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := client.V3.GetProject(uuid)
+
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+				return v, "DELETED", nil
+			}
+			log.Printf("ERROR %s", err)
+			return nil, "", err
+		}
+
+		if v.Status == nil {
+			return v, "PENDING", nil
+		}
+
+		return v, utils.StringValue(v.Status.State), nil
+	}
+}
+
+func getProjectSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_version": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"metadata": getComputedMetadataSchema(),
+		"categories": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"default_subnet_reference": {
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressReferenceDiff,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"kind": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"uuid": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"subnet_reference_list":              getReferenceListSchema(),
+		"user_reference_list":                getReferenceListSchema(),
+		"external_user_group_reference_list": getReferenceListSchema(),
+		"resource_domain": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"resource_type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateOneOf(projectResourceTypes...),
+					},
+					"limit": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+					"units": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"value": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package nutanix
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNutanixProject_basic(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixProjectConfig(r, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixProjectExists("nutanix_project.test"),
+					resource.TestCheckResourceAttr("nutanix_project.test", "name", fmt.Sprintf("project-%d", r)),
+					resource.TestCheckResourceAttr("nutanix_project.test", "subnet_reference_list.#", "1"),
+					resource.TestCheckResourceAttr("nutanix_project.test", "resource_domain.#", "2"),
+					testAccCheckNutanixImageExists("nutanix_image.test"),
+					resource.TestCheckResourceAttrPair("nutanix_image.test", "project_reference.uuid", "nutanix_project.test", "id"),
+				),
+			},
+			{
+				Config: testAccNutanixProjectConfig(r, "other"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("nutanix_image.test", "project_reference.uuid", "nutanix_project.other", "id"),
+					resource.TestCheckResourceAttrPair("nutanix_image.test", "project_reference.name", "nutanix_project.other", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNutanixProjectExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNutanixProjectDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*NutanixClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nutanix_project" {
+			continue
+		}
+		for {
+			_, err := conn.API.V3.GetProject(rs.Primary.ID)
+			if err != nil {
+				if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+					return nil
+				}
+				return err
+			}
+			time.Sleep(3000 * time.Millisecond)
+		}
+	}
+
+	return nil
+}
+
+func testAccNutanixProjectConfig(r int, imageProject string) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_subnet" "test" {
+  metadata = {
+    kind = "subnet"
+  }
+
+  name        = "project_vlan_%d"
+  description = "Project Vlan"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  vlan_id     = 202
+  subnet_type = "VLAN"
+
+  prefix_length      = 24
+  default_gateway_ip = "192.168.2.1"
+  subnet_ip          = "192.168.2.0"
+}
+
+resource "nutanix_project" "test" {
+  name        = "project-%d"
+  description = "Self-service tenant"
+
+  default_subnet_reference = {
+    uuid = "${nutanix_subnet.test.id}"
+  }
+
+  subnet_reference_list = [
+    {
+      uuid = "${nutanix_subnet.test.id}"
+    },
+  ]
+
+  resource_domain = [
+    {
+      resource_type = "VCPUS"
+      limit         = 16
+    },
+    {
+      resource_type = "MEMORY"
+      limit         = 34359738368
+    },
+  ]
+}
+
+resource "nutanix_project" "other" {
+  name        = "project-other-%d"
+  description = "Second tenant"
+}
+
+resource "nutanix_image" "test" {
+  name        = "Ubuntu-%d"
+  description = "Ubuntu"
+  source_uri  = "http://archive.ubuntu.com/ubuntu/dists/bionic/main/installer-amd64/current/images/netboot/mini.iso"
+
+  metadata = {
+    kind = "image"
+  }
+
+  project_reference = {
+    name = "${nutanix_project.%s.name}"
+  }
+}
+`, r, r, r, r, imageProject)
+}
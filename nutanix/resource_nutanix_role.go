@@ -0,0 +1,339 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func resourceNutanixRole() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNutanixRoleCreate,
+		Read:     resourceNutanixRoleRead,
+		Update:   resourceNutanixRoleUpdate,
+		Delete:   resourceNutanixRoleDelete,
+		Importer: importStateByName(unscopedLookup("role", findRoleByName)),
+		Schema:   getRoleSchema(),
+	}
+}
+
+func resourceNutanixRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Creating Role: %s", d.Get("name").(string))
+
+	conn := meta.(*NutanixClient).API
+
+	spec, err := getRoleSpec(conn, d)
+	if err != nil {
+		return err
+	}
+
+	request := &v3.RoleInput{
+		Metadata: &v3.Metadata{
+			Kind: utils.String("role"),
+		},
+		Spec: spec,
+	}
+
+	if v, ok := d.GetOk("api_version"); ok {
+		request.APIVersion = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("categories"); ok {
+		request.Metadata.Categories = expandCategoryAssignment(v.(map[string]interface{}))
+	}
+
+	utils.PrintToJSON(request, "CREATE METHOD REQUEST")
+
+	resp, err := conn.V3.CreateRole(request)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(utils.StringValue(resp.Metadata.UUID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    roleStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for role (%s) to create: %s", d.Id(), err)
+	}
+
+	return resourceNutanixRoleRead(d, meta)
+}
+
+func resourceNutanixRoleRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Reading Role: %s", d.Id())
+
+	conn := meta.(*NutanixClient).API
+
+	resp, err := conn.V3.GetRole(d.Id())
+	if err != nil {
+		if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if err := d.Set("metadata", flattenMetadata(resp.Metadata)); err != nil {
+		return err
+	}
+	if err := d.Set("categories", resp.Metadata.Categories); err != nil {
+		return err
+	}
+	if err := d.Set("api_version", utils.StringValue(resp.APIVersion)); err != nil {
+		return err
+	}
+
+	status := resp.Status
+	if status == nil {
+		status = &v3.RoleStatus{}
+	}
+	if err := d.Set("name", utils.StringValue(status.Name)); err != nil {
+		return err
+	}
+	if err := d.Set("description", utils.StringValue(status.Description)); err != nil {
+		return err
+	}
+	if err := d.Set("state", utils.StringValue(status.State)); err != nil {
+		return err
+	}
+	if err := d.Set("is_system_defined", utils.BoolValue(status.IsSystemDefined)); err != nil {
+		return err
+	}
+
+	permissions := make([]map[string]interface{}, 0)
+	if status.Resources != nil {
+		permissions = flattenReferenceList(status.Resources.PermissionReferenceList)
+	}
+
+	return d.Set("permission_reference_list", permissions)
+}
+
+func resourceNutanixRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	log.Printf("[DEBUG] Updating Role: %s", d.Id())
+
+	resp, err := conn.V3.GetRole(d.Id())
+	if err != nil {
+		return err
+	}
+
+	spec, err := getRoleSpec(conn, d)
+	if err != nil {
+		return err
+	}
+
+	request := &v3.RoleInput{
+		APIVersion: resp.APIVersion,
+		Metadata:   resp.Metadata,
+		Spec:       spec,
+	}
+
+	if d.HasChange("categories") {
+		request.Metadata.Categories = expandCategoryAssignment(d.Get("categories").(map[string]interface{}))
+	}
+
+	utils.PrintToJSON(request, "UPDATE METHOD REQUEST")
+
+	if _, err := conn.V3.UpdateRole(d.Id(), request); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    roleStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for role (%s) to update: %s", d.Id(), err)
+	}
+
+	return resourceNutanixRoleRead(d, meta)
+}
+
+func resourceNutanixRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	log.Printf("[DEBUG] Deleting Role: %s", d.Id())
+
+	if err := conn.V3.DeleteRole(d.Id()); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING", "DELETE_IN_PROGRESS", "COMPLETE"},
+		Target:     []string{"DELETED"},
+		Refresh:    roleStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for role (%s) to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getRoleSpec builds the role spec, resolving permissions given by name to their UUIDs.
+func getRoleSpec(conn *v3.Client, d *schema.ResourceData) (*v3.Role, error) {
+	spec := &v3.Role{
+		Name:      utils.String(d.Get("name").(string)),
+		Resources: &v3.RoleResources{},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		spec.Description = utils.String(v.(string))
+	}
+
+	o, _ := d.GetChange("permission_reference_list")
+	previous := o.([]interface{})
+
+	permissions := expandReferenceList(d.Get("permission_reference_list").([]interface{}), "permission")
+	for k, p := range permissions {
+		// The uuid is kept from the state when only the name is configured, it belongs to another permission
+		// once the entry at this position is renamed.
+		if p.UUID != nil && p.Name != nil && k < len(previous) {
+			prev := previous[k].(map[string]interface{})
+			if prev["name"] != utils.StringValue(p.Name) && prev["uuid"] == utils.StringValue(p.UUID) {
+				p.UUID = nil
+			}
+		}
+		if p.UUID != nil {
+			continue
+		}
+		if p.Name == nil {
+			return nil, fmt.Errorf("permission_reference_list entries require either uuid or name")
+		}
+		uuid, err := findPermissionByName(conn, utils.StringValue(p.Name))
+		if err != nil {
+			return nil, err
+		}
+		p.UUID = utils.String(uuid)
+	}
+	spec.Resources.PermissionReferenceList = permissions
+
+	return spec, nil
+}
+
+func findRoleByName(conn *v3.Client, name string) (string, error) {
+	log.Printf("[DEBUG] Looking up Role: %s", name)
+
+	var uuids []string
+	err := utils.ListAll(100, func(offset, length int64) (int, int64, error) {
+		resp, err := conn.V3.ListRole(listPage("role", fiqlEquals("name", name), offset, length))
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, r := range resp.Entities {
+			if r.Spec != nil && utils.StringValue(r.Spec.Name) == name {
+				uuids = append(uuids, utils.StringValue(r.Metadata.UUID))
+			}
+		}
+		return len(resp.Entities), listTotal(resp.Metadata), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return singleMatch("role", name, uuids)
+}
+
+func roleStateRefreshFunc(client *v3.Client, uuid string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := client.V3.GetRole(uuid)
+
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+				return v, "DELETED", nil
+			}
+			log.Printf("ERROR %s", err)
+			return nil, "", err
+		}
+
+		if v.Status == nil {
+			return v, "PENDING", nil
+		}
+
+		return v, utils.StringValue(v.Status.State), nil
+	}
+}
+
+func getRoleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_version": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"metadata": getComputedMetadataSchema(),
+		"categories": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"is_system_defined": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"permission_reference_list": {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"kind": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"uuid": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,107 @@
+package nutanix
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNutanixRole_basic(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixRoleConfig(r, "Create_Virtual_Machine"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixRoleExists("nutanix_role.test"),
+					resource.TestCheckResourceAttr("nutanix_role.test", "name", fmt.Sprintf("role-%d", r)),
+					resource.TestCheckResourceAttr("nutanix_role.test", "permission_reference_list.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"nutanix_role.test", "permission_reference_list.1.uuid", "data.nutanix_permission.test", "id"),
+					resource.TestCheckResourceAttr("nutanix_role.test", "is_system_defined", "false"),
+					resource.TestCheckResourceAttrPair(
+						"nutanix_role.test", "permission_reference_list.0.uuid", "data.nutanix_permission.first", "id"),
+				),
+			},
+			{
+				Config: testAccNutanixRoleConfig(r, "Delete_Virtual_Machine"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_role.test", "permission_reference_list.0.name", "Delete_Virtual_Machine"),
+					resource.TestCheckResourceAttrPair(
+						"nutanix_role.test", "permission_reference_list.0.uuid", "data.nutanix_permission.first", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNutanixRoleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNutanixRoleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*NutanixClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nutanix_role" {
+			continue
+		}
+		for {
+			_, err := conn.API.V3.GetRole(rs.Primary.ID)
+			if err != nil {
+				if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+					return nil
+				}
+				return err
+			}
+			time.Sleep(3000 * time.Millisecond)
+		}
+	}
+
+	return nil
+}
+
+func testAccNutanixRoleConfig(r int, permission string) string {
+	return fmt.Sprintf(`
+data "nutanix_permission" "test" {
+  name = "View_Virtual_Machine"
+}
+
+data "nutanix_permission" "first" {
+  name = "%[2]s"
+}
+
+resource "nutanix_role" "test" {
+  name        = "role-%[1]d"
+  description = "VM operator"
+
+  permission_reference_list = [
+    {
+      name = "%[2]s"
+    },
+    {
+      uuid = "${data.nutanix_permission.test.id}"
+    },
+  ]
+}
+`, r, permission)
+}
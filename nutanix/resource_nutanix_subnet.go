@@ -15,14 +15,12 @@ import (
 
 func resourceNutanixSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNutanixSubnetCreate,
-		Read:   resourceNutanixSubnetRead,
-		Update: resourceNutanixSubnetUpdate,
-		Delete: resourceNutanixSubnetDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Schema: getSubnetSchema(),
+		Create:   resourceNutanixSubnetCreate,
+		Read:     resourceNutanixSubnetRead,
+		Update:   resourceNutanixSubnetUpdate,
+		Delete:   resourceNutanixSubnetDelete,
+		Importer: importStateByName(lookupSubnet),
+		Schema:   getSubnetSchema(),
 	}
 }
 
@@ -54,7 +52,10 @@ func resourceNutanixSubnetCreate(d *schema.ResourceData, meta interface{}) error
 	if !nok {
 		return fmt.Errorf("Please provide the required name attribute")
 	}
-	if err := getSubnetMetadaAttributes(d, metadata); err != nil {
+	if err := getSubnetMetadaAttributes(d, metadata, meta.(*NutanixClient).DefaultCategories); err != nil {
+		return err
+	}
+	if err := resolveProjectReference(conn, metadata.ProjectReference); err != nil {
 		return err
 	}
 	if descok {
@@ -153,7 +154,15 @@ func resourceNutanixSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("metadata", metadata); err != nil {
 		return err
 	}
-	if err := d.Set("categories", resp.Metadata.Categories); err != nil {
+	categories := flattenCategories(meta.(*NutanixClient).DefaultCategories, resp.Metadata.Categories, d.Get("categories").(map[string]interface{}))
+	if err := d.Set("categories", categories); err != nil {
+		return err
+	}
+	pr := make(map[string]interface{})
+	if resp.Metadata.ProjectReference != nil {
+		pr = flattenReference(resp.Metadata.ProjectReference)
+	}
+	if err := d.Set("project_reference", pr); err != nil {
 		return err
 	}
 
@@ -379,11 +388,7 @@ func resourceNutanixSubnetUpdate(d *schema.ResourceData, meta interface{}) error
 
 	if d.HasChange("categories") {
 		p := d.Get("categories").(map[string]interface{})
-		labels := map[string]string{}
-		for k, v := range p {
-			labels[k] = v.(string)
-		}
-		metadata.Categories = labels
+		metadata.Categories = mergeDefaultCategories(meta.(*NutanixClient).DefaultCategories, p)
 	}
 	if d.HasChange("owner_reference") {
 		or := d.Get("owner_reference").(map[string]interface{})
@@ -396,12 +401,10 @@ func resourceNutanixSubnetUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 	if d.HasChange("project_reference") {
 		pr := d.Get("project_reference").(map[string]interface{})
-		r := &v3.Reference{
-			Kind: utils.String(pr["kind"].(string)),
-			UUID: utils.String(pr["uuid"].(string)),
-			Name: utils.String(pr["name"].(string)),
+		metadata.ProjectReference = expandReference(pr, "project")
+		if err := resolveProjectReference(conn, metadata.ProjectReference); err != nil {
+			return err
 		}
-		metadata.ProjectReference = r
 	}
 	if d.HasChange("name") {
 		spec.Name = utils.String(d.Get("name").(string))
@@ -690,7 +693,7 @@ func getSubnetResources(d *schema.ResourceData, subnet *v3.SubnetResources) erro
 	return nil
 }
 
-func getSubnetMetadaAttributes(d *schema.ResourceData, metadata *v3.SubnetMetadata) error {
+func getSubnetMetadaAttributes(d *schema.ResourceData, metadata *v3.SubnetMetadata, defaults map[string]string) error {
 	m, mok := d.GetOk("metadata")
 	metad := m.(map[string]interface{})
 
@@ -716,25 +719,12 @@ func getSubnetMetadaAttributes(d *schema.ResourceData, metadata *v3.SubnetMetada
 	if v, ok := metad["name"]; ok {
 		metadata.Name = utils.String(v.(string))
 	}
-	if v, ok := d.GetOk("categories"); ok {
-		c := v.(map[string]interface{})
-		labels := map[string]string{}
-
-		for k, v := range c {
-			labels[k] = v.(string)
-		}
-		metadata.Categories = labels
+	if v, ok := d.GetOk("categories"); ok || len(defaults) > 0 {
+		c, _ := v.(map[string]interface{})
+		metadata.Categories = mergeDefaultCategories(defaults, c)
 	}
 	if p, ok := d.GetOk("project_reference"); ok {
-		pr := p.(map[string]interface{})
-		r := &v3.Reference{
-			Kind: utils.String(pr["kind"].(string)),
-			UUID: utils.String(pr["uuid"].(string)),
-		}
-		if v1, ok1 := pr["name"]; ok1 {
-			r.Name = utils.String(v1.(string))
-		}
-		metadata.ProjectReference = r
+		metadata.ProjectReference = expandReference(p.(map[string]interface{}), "project")
 	}
 	if o, ok := metad["owner_reference"]; ok {
 		or := o.(map[string]interface{})
@@ -1083,8 +1073,10 @@ func getSubnetSchema() map[string]*schema.Schema {
 			},
 		},
 		"project_reference": {
-			Type:     schema.TypeMap,
-			Optional: true,
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressReferenceDiff,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"kind": {
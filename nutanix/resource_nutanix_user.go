@@ -0,0 +1,353 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func resourceNutanixUser() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNutanixUserCreate,
+		Read:     resourceNutanixUserRead,
+		Update:   resourceNutanixUserUpdate,
+		Delete:   resourceNutanixUserDelete,
+		Importer: importStateByName(unscopedLookup("user", findUserByName)),
+		Schema:   getUserSchema(),
+	}
+}
+
+func resourceNutanixUserCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Creating User: %s", d.Get("user_principal_name").(string))
+
+	conn := meta.(*NutanixClient).API
+
+	ds := expandReference(d.Get("directory_service_reference").(map[string]interface{}), "directory_service")
+	if ds.UUID == nil {
+		if ds.Name == nil {
+			return fmt.Errorf("directory_service_reference requires either uuid or name")
+		}
+		uuid, err := findDirectoryServiceByName(conn, utils.StringValue(ds.Name))
+		if err != nil {
+			return err
+		}
+		ds.UUID = utils.String(uuid)
+	}
+
+	request := &v3.UserInput{
+		Metadata: &v3.Metadata{
+			Kind: utils.String("user"),
+		},
+		Spec: &v3.User{
+			Resources: &v3.UserResources{
+				DirectoryServiceUser: &v3.DirectoryServiceUser{
+					UserPrincipalName:         utils.String(d.Get("user_principal_name").(string)),
+					DirectoryServiceReference: ds,
+				},
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("api_version"); ok {
+		request.APIVersion = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("categories"); ok {
+		request.Metadata.Categories = expandCategoryAssignment(v.(map[string]interface{}))
+	}
+
+	utils.PrintToJSON(request, "CREATE METHOD REQUEST")
+
+	resp, err := conn.V3.CreateUser(request)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(utils.StringValue(resp.Metadata.UUID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    userStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for user (%s) to create: %s", d.Id(), err)
+	}
+
+	return resourceNutanixUserRead(d, meta)
+}
+
+func resourceNutanixUserRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Reading User: %s", d.Id())
+
+	conn := meta.(*NutanixClient).API
+
+	resp, err := conn.V3.GetUser(d.Id())
+	if err != nil {
+		if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if err := d.Set("metadata", flattenMetadata(resp.Metadata)); err != nil {
+		return err
+	}
+	if err := d.Set("categories", resp.Metadata.Categories); err != nil {
+		return err
+	}
+	if err := d.Set("api_version", utils.StringValue(resp.APIVersion)); err != nil {
+		return err
+	}
+
+	status := resp.Status
+	if status == nil {
+		status = &v3.UserStatus{}
+	}
+	if err := d.Set("name", utils.StringValue(status.Name)); err != nil {
+		return err
+	}
+	if err := d.Set("state", utils.StringValue(status.State)); err != nil {
+		return err
+	}
+
+	res := status.Resources
+	if res == nil {
+		res = &v3.UserResources{}
+	}
+	if err := d.Set("display_name", utils.StringValue(res.DisplayName)); err != nil {
+		return err
+	}
+	if err := d.Set("user_type", utils.StringValue(res.UserType)); err != nil {
+		return err
+	}
+	if res.DirectoryServiceUser != nil {
+		if err := d.Set("user_principal_name", utils.StringValue(res.DirectoryServiceUser.UserPrincipalName)); err != nil {
+			return err
+		}
+		if res.DirectoryServiceUser.DirectoryServiceReference != nil {
+			ds := flattenReference(res.DirectoryServiceUser.DirectoryServiceReference)
+			if err := d.Set("directory_service_reference", ds); err != nil {
+				return err
+			}
+		}
+	}
+	if err := d.Set("access_control_policy_reference_list", flattenReferenceList(res.AccessControlPolicyReferenceList)); err != nil {
+		return err
+	}
+
+	return d.Set("projects_reference_list", flattenReferenceList(res.ProjectsReferenceList))
+}
+
+func resourceNutanixUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	log.Printf("[DEBUG] Updating User: %s", d.Id())
+
+	// Only the metadata of a user can change, everything else forces a new user.
+	resp, err := conn.V3.GetUser(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := &v3.UserInput{
+		APIVersion: resp.APIVersion,
+		Metadata:   resp.Metadata,
+		Spec:       resp.Spec,
+	}
+	request.Metadata.Categories = expandCategoryAssignment(d.Get("categories").(map[string]interface{}))
+
+	utils.PrintToJSON(request, "UPDATE METHOD REQUEST")
+
+	if _, err := conn.V3.UpdateUser(d.Id(), request); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    userStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for user (%s) to update: %s", d.Id(), err)
+	}
+
+	return resourceNutanixUserRead(d, meta)
+}
+
+func resourceNutanixUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	log.Printf("[DEBUG] Deleting User: %s", d.Id())
+
+	if err := conn.V3.DeleteUser(d.Id()); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING", "DELETE_IN_PROGRESS", "COMPLETE"},
+		Target:     []string{"DELETED"},
+		Refresh:    userStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for user (%s) to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func findDirectoryServiceByName(conn *v3.Client, name string) (string, error) {
+	log.Printf("[DEBUG] Looking up Directory Service: %s", name)
+
+	var uuids []string
+	err := utils.ListAll(100, func(offset, length int64) (int, int64, error) {
+		resp, err := conn.V3.ListDirectoryService(listPage("directory_service", fiqlEquals("name", name), offset, length))
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, ds := range resp.Entities {
+			if ds.Spec != nil && utils.StringValue(ds.Spec.Name) == name {
+				uuids = append(uuids, utils.StringValue(ds.Metadata.UUID))
+			}
+		}
+		return len(resp.Entities), listTotal(resp.Metadata), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return singleMatch("directory_service", name, uuids)
+}
+
+func userStateRefreshFunc(client *v3.Client, uuid string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := client.V3.GetUser(uuid)
+
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+				return v, "DELETED", nil
+			}
+			log.Printf("ERROR %s", err)
+			return nil, "", err
+		}
+
+		if v.Status == nil {
+			return v, "PENDING", nil
+		}
+
+		return v, utils.StringValue(v.Status.State), nil
+	}
+}
+
+func getUserSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_version": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"metadata": getComputedMetadataSchema(),
+		"categories": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"user_principal_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"directory_service_reference": {
+			Type:             schema.TypeMap,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppressReferenceDiff,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"kind": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"uuid": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"user_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"access_control_policy_reference_list": getComputedReferenceListSchema(),
+		"projects_reference_list":              getComputedReferenceListSchema(),
+	}
+}
+
+// findUserByName returns the UUID of the only user with the given name, which for directory users is the
+// user principal name.
+func findUserByName(conn *v3.Client, name string) (string, error) {
+	log.Printf("[DEBUG] Looking up User: %s", name)
+
+	var uuids []string
+	err := utils.ListAll(100, func(offset, length int64) (int, int64, error) {
+		resp, err := conn.V3.ListUser(listPage("user", fiqlEquals("username", name), offset, length))
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, u := range resp.Entities {
+			if u.Status != nil && utils.StringValue(u.Status.Name) == name {
+				uuids = append(uuids, utils.StringValue(u.Metadata.UUID))
+			}
+		}
+		return len(resp.Entities), listTotal(resp.Metadata), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return singleMatch("user", name, uuids)
+}
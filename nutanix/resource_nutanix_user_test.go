@@ -0,0 +1,85 @@
+package nutanix
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNutanixUser_basic(t *testing.T) {
+	directory := os.Getenv("NUTANIX_DIRECTORY_SERVICE")
+	principal := os.Getenv("NUTANIX_DIRECTORY_USER")
+	if directory == "" || principal == "" {
+		t.Skip("NUTANIX_DIRECTORY_SERVICE and NUTANIX_DIRECTORY_USER must be set for user acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixUserConfig(directory, principal),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixUserExists("nutanix_user.test"),
+					resource.TestCheckResourceAttr("nutanix_user.test", "user_principal_name", principal),
+					resource.TestCheckResourceAttrSet("nutanix_user.test", "directory_service_reference.uuid"),
+					resource.TestCheckResourceAttr("nutanix_user.test", "user_type", "DIRECTORY_SERVICE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNutanixUserExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNutanixUserDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*NutanixClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nutanix_user" {
+			continue
+		}
+		for {
+			_, err := conn.API.V3.GetUser(rs.Primary.ID)
+			if err != nil {
+				if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+					return nil
+				}
+				return err
+			}
+			time.Sleep(3000 * time.Millisecond)
+		}
+	}
+
+	return nil
+}
+
+func testAccNutanixUserConfig(directory, principal string) string {
+	return fmt.Sprintf(`
+resource "nutanix_user" "test" {
+  user_principal_name = "%s"
+
+  directory_service_reference = {
+    name = "%s"
+  }
+}
+`, principal, directory)
+}
@@ -16,19 +16,24 @@ import (
 
 func resourceNutanixVirtualMachine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNutanixVirtualMachineCreate,
-		Read:   resourceNutanixVirtualMachineRead,
-		Update: resourceNutanixVirtualMachineUpdate,
-		Delete: resourceNutanixVirtualMachineDelete,
-		Exists: resourceNutanixVirtualMachineExists,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-		Schema: getVMSchema(),
+		Create:   resourceNutanixVirtualMachineCreate,
+		Read:     resourceNutanixVirtualMachineRead,
+		Update:   resourceNutanixVirtualMachineUpdate,
+		Delete:   resourceNutanixVirtualMachineDelete,
+		Exists:   resourceNutanixVirtualMachineExists,
+		Importer: importStateByName(lookupVM),
+		Schema:   getVMSchema(),
 	}
 }
 
 func resourceNutanixVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := checkVMBootConfig(d); err != nil {
+		return err
+	}
+	if source, ok := d.GetOk("clone_from_vm"); ok {
+		return resourceNutanixVirtualMachineClone(d, meta, source.(string))
+	}
+
 	// Get client connection
 	conn := meta.(*NutanixClient).API
 
@@ -50,7 +55,10 @@ func resourceNutanixVirtualMachineCreate(d *schema.ResourceData, meta interface{
 	if !nok {
 		return fmt.Errorf("Please provide the required name attribute")
 	}
-	if err := getMetadaAttributes(d, metadata); err != nil {
+	if err := getMetadaAttributes(d, metadata, meta.(*NutanixClient).DefaultCategories); err != nil {
+		return err
+	}
+	if err := resolveProjectReference(conn, metadata.ProjectReference); err != nil {
 		return err
 	}
 	if descok {
@@ -82,14 +90,32 @@ func resourceNutanixVirtualMachineCreate(d *schema.ResourceData, meta interface{
 	if err := getVMResources(d, res); err != nil {
 		return err
 	}
+	if err := resolveVMGpus(conn, d, res.GpuList); err != nil {
+		return err
+	}
+	if v, ok := d.GetOk("cdrom"); ok {
+		disks, err := syncVMCdroms(conn, res.DiskList, nil, v.([]interface{}))
+		if err != nil {
+			return err
+		}
+		res.DiskList = disks
+	}
+	if _, ok := d.GetOk("ngt"); ok {
+		res.GuestTools = &v3.GuestToolsSpec{NutanixGuestTools: expandVMNgt(d)}
+	}
+	spec.Resources = res
+
+	// A boot device the VM does not have an address or MAC address for yet is set once the VM exists.
+	bootPending := false
+	if err := applyVMBootConfig(d, spec); err != nil {
+		log.Printf("[DEBUG] Setting the boot device of the vm once it is created: %s", err)
+		bootPending = true
+	}
 
 	spec.Name = utils.String(n.(string))
-	spec.Resources = res
 	request.Metadata = metadata
 	request.Spec = spec
 
-	utils.PrintToJSON(request, "REQUEST VM")
-
 	// Make request to the API
 	resp, err := conn.V3.CreateVM(request)
 	if err != nil {
@@ -100,6 +126,9 @@ func resourceNutanixVirtualMachineCreate(d *schema.ResourceData, meta interface{
 
 	// Set terraform state id
 	d.SetId(uuid)
+	if err := hashCloudInitState(d); err != nil {
+		return err
+	}
 
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
@@ -116,6 +145,21 @@ func resourceNutanixVirtualMachineCreate(d *schema.ResourceData, meta interface{
 			"Error waiting for vm (%s) to create: %s", d.Id(), err)
 	}
 
+	if bootPending {
+		err := updateVMSpec(conn, d.Id(), func(spec *v3.VM) error {
+			return applyVMBootConfig(d, spec)
+		})
+		if err != nil {
+			return fmt.Errorf("Error setting the boot config of vm (%s): %s", d.Id(), err)
+		}
+	}
+
+	if ngtWaitForCommunication(d) {
+		if err := waitForVMNgtCommunication(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
 	// Read the ip
 	if resp.Spec.Resources.NicList != nil && *resp.Spec.Resources.PowerState == "ON" {
 		log.Printf("[DEBUG] Polling for IP\n")
@@ -128,6 +172,201 @@ func resourceNutanixVirtualMachineCreate(d *schema.ResourceData, meta interface{
 	return resourceNutanixVirtualMachineRead(d, meta)
 }
 
+// resourceNutanixVirtualMachineClone creates the VM as a clone of another VM. Name, CPU, memory, NICs and guest
+// customization are passed as overrides to the clone action, the remaining arguments are set with updates once
+// the clone exists. Disks are always those of the source VM, disk_list conflicts with clone_from_vm.
+func resourceNutanixVirtualMachineClone(d *schema.ResourceData, meta interface{}, source string) error {
+	conn := meta.(*NutanixClient).API
+
+	res := &v3.VMResources{}
+	if err := getVMResources(d, res); err != nil {
+		return err
+	}
+
+	request := &v3.VMCloneInput{
+		OverrideSpec: &v3.VMCloneOverrideSpec{
+			Name:               utils.String(d.Get("name").(string)),
+			NumSockets:         res.NumSockets,
+			NumVcpusPerSocket:  res.NumVcpusPerSocket,
+			MemorySizeMib:      res.MemorySizeMib,
+			NicList:            res.NicList,
+			GuestCustomization: res.GuestCustomization,
+		},
+	}
+
+	log.Printf("[DEBUG] Cloning VM: %s", source)
+
+	task, err := conn.V3.CloneVM(source, request)
+	if err != nil {
+		return fmt.Errorf("Error cloning vm (%s): %s", source, err)
+	}
+
+	taskUUID := utils.StringValue(task.TaskUUID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"QUEUED", "RUNNING"},
+		Target:     []string{"SUCCEEDED"},
+		Refresh:    taskStateRefreshFunc(conn, taskUUID),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	result, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for vm (%s) to clone: %s", source, err)
+	}
+
+	// The task references both the source VM and the clone.
+	for _, ref := range result.(*v3.Task).EntityReferenceList {
+		if utils.StringValue(ref.Kind) == "vm" && utils.StringValue(ref.UUID) != source {
+			d.SetId(utils.StringValue(ref.UUID))
+		}
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("clone task %s of vm (%s) did not report the new vm", taskUUID, source)
+	}
+	if err := hashCloudInitState(d); err != nil {
+		return err
+	}
+
+	stateConf = &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    vmStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for vm (%s) to create: %s", d.Id(), err)
+	}
+
+	if err := updateClonedVM(d, meta, res.GpuList); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("cdrom"); ok {
+		err := updateVMSpec(conn, d.Id(), func(spec *v3.VM) error {
+			disks, err := syncVMCdroms(conn, spec.Resources.DiskList, nil, v.([]interface{}))
+			spec.Resources.DiskList = disks
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Error attaching cdroms to vm (%s): %s", d.Id(), err)
+		}
+	}
+
+	_, bootType := d.GetOk("boot_type")
+	_, machineType := d.GetOk("machine_type")
+	_, bootOrder := d.GetOk("boot_order")
+	if bootType || machineType || bootOrder {
+		err := updateVMSpec(conn, d.Id(), func(spec *v3.VM) error {
+			return applyVMBootConfig(d, spec)
+		})
+		if err != nil {
+			return fmt.Errorf("Error setting the boot config of vm (%s): %s", d.Id(), err)
+		}
+	}
+
+	if _, ok := d.GetOk("ngt"); ok {
+		err := updateVMSpec(conn, d.Id(), func(spec *v3.VM) error {
+			spec.Resources.GuestTools = &v3.GuestToolsSpec{NutanixGuestTools: expandVMNgt(d)}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error setting guest tools of vm (%s): %s", d.Id(), err)
+		}
+		if ngtWaitForCommunication(d) {
+			if err := waitForVMNgtCommunication(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if res.NicList != nil && d.Get("power_state").(string) == "ON" {
+		log.Printf("[DEBUG] Polling for IP\n")
+		if err := waitForIP(conn, d.Id(), d); err != nil {
+			return err
+		}
+	}
+
+	return resourceNutanixVirtualMachineRead(d, meta)
+}
+
+// updateClonedVM applies the arguments the clone action cannot override. Categories, project, owner and GPUs
+// are only replaced when the configuration or the provider sets some, otherwise the clone keeps those copied
+// from the source.
+func updateClonedVM(d *schema.ResourceData, meta interface{}, gpus []*v3.VMGpu) error {
+	conn := meta.(*NutanixClient).API
+	defaults := meta.(*NutanixClient).DefaultCategories
+
+	resp, err := conn.V3.GetVM(d.Id())
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	spec := resp.Spec
+	categories := d.Get("categories").(map[string]interface{})
+	if len(categories) > 0 || len(defaults) > 0 {
+		resp.Metadata.Categories = mergeDefaultCategories(defaults, categories)
+		changed = true
+	}
+	if v, ok := d.GetOk("project_reference"); ok {
+		resp.Metadata.ProjectReference = expandReference(v.(map[string]interface{}), "project")
+		if err := resolveProjectReference(conn, resp.Metadata.ProjectReference); err != nil {
+			return err
+		}
+		changed = true
+	}
+	if v, ok := d.GetOk("owner_reference"); ok {
+		resp.Metadata.OwnerReference = expandReference(v.(map[string]interface{}), "user")
+		changed = true
+	}
+	if len(gpus) > 0 {
+		if err := resolveVMGpus(conn, d, gpus); err != nil {
+			return err
+		}
+		spec.Resources.GpuList = gpus
+		changed = true
+	}
+	if v, ok := d.GetOk("description"); ok {
+		spec.Description = utils.String(v.(string))
+		changed = true
+	}
+	if v, ok := d.GetOk("power_state"); ok && v.(string) != utils.StringValue(spec.Resources.PowerState) {
+		spec.Resources.PowerState = utils.String(v.(string))
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	if _, err := conn.V3.UpdateVM(d.Id(), &v3.VMIntentInput{Metadata: resp.Metadata, Spec: spec}); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    vmStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for vm (%s) to update: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
 func resourceNutanixVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
 	// Get client connection
 	conn := meta.(*NutanixClient).API
@@ -153,7 +392,8 @@ func resourceNutanixVirtualMachineRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("metadata", metadata); err != nil {
 		return err
 	}
-	if err := d.Set("categories", resp.Metadata.Categories); err != nil {
+	categories := flattenCategories(meta.(*NutanixClient).DefaultCategories, resp.Metadata.Categories, d.Get("categories").(map[string]interface{}))
+	if err := d.Set("categories", categories); err != nil {
 		return err
 	}
 	pr := make(map[string]interface{})
@@ -217,52 +457,8 @@ func resourceNutanixVirtualMachineRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	// set nic list value
-	nics := resp.Status.Resources.NicList
-	nicLists := make([]map[string]interface{}, 0)
-	if nics != nil {
-		nicLists = make([]map[string]interface{}, len(nics))
-		for k, v := range nics {
-			nic := make(map[string]interface{})
-			// simple first
-			nic["nic_type"] = utils.StringValue(v.NicType)
-			nic["uuid"] = utils.StringValue(v.UUID)
-			nic["floating_ip"] = utils.StringValue(v.FloatingIP)
-			nic["network_function_nic_type"] = utils.StringValue(v.NetworkFunctionNicType)
-			nic["mac_address"] = utils.StringValue(v.MacAddress)
-			nic["model"] = utils.StringValue(v.Model)
-
-			// set ip lists value
-			ipEndpointList := make([]map[string]interface{}, len(v.IPEndpointList))
-			for k1, v1 := range v.IPEndpointList {
-				ipEndpoint := make(map[string]interface{})
-				ipEndpoint["ip"] = utils.StringValue(v1.IP)
-				ipEndpoint["type"] = utils.StringValue(v1.Type)
-				ipEndpointList[k1] = ipEndpoint
-			}
-			nic["ip_endpoint_list"] = ipEndpointList
-
-			// set network_function_chain_reference value
-			netFnChainRef := make(map[string]interface{})
-			if v.NetworkFunctionChainReference != nil {
-				netFnChainRef["kind"] = utils.StringValue(v.NetworkFunctionChainReference.Kind)
-				netFnChainRef["name"] = utils.StringValue(v.NetworkFunctionChainReference.Name)
-				netFnChainRef["uuid"] = utils.StringValue(v.NetworkFunctionChainReference.UUID)
-			}
-			nic["network_function_chain_reference"] = netFnChainRef
-
-			// set subnet_reference value
-			subtnetRef := make(map[string]interface{})
-			if v.SubnetReference != nil {
-				subtnetRef["kind"] = utils.StringValue(v.SubnetReference.Kind)
-				subtnetRef["name"] = utils.StringValue(v.SubnetReference.Name)
-				subtnetRef["uuid"] = utils.StringValue(v.SubnetReference.UUID)
-			}
-			nic["subnet_reference"] = subtnetRef
-
-			nicLists[k] = nic
-		}
-
-	}
+	managedNics := vmNicListUUIDs(d, d.Get("nic_list").([]interface{}))
+	nicLists := flattenVMNicList(resp.Status.Resources.NicList, managedNics)
 	if err := d.Set("nic_list", nicLists); err != nil {
 		return err
 	}
@@ -307,6 +503,9 @@ func resourceNutanixVirtualMachineRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("nutanix_guest_tools", nutanixGuestTools); err != nil {
 		return err
 	}
+	if err := d.Set("ngt", flattenVMNgt(d, resp.Status.Resources.GuestTools)); err != nil {
+		return err
+	}
 	// set num_vcpus_per_socket value
 	if err := d.Set("num_vcpus_per_socket", resp.Status.Resources.NumVcpusPerSocket); err != nil {
 		return err
@@ -330,6 +529,10 @@ func resourceNutanixVirtualMachineRead(d *schema.ResourceData, meta interface{})
 			gpu["num_virtual_display_heads"] = utils.Int64Value(v.NumVirtualDisplayHeads)
 			gpu["guest_driver_version"] = utils.StringValue(v.GuestDriverVersion)
 			gpu["device_id"] = utils.Int64Value(v.DeviceID)
+			gpu["host_uuid"] = ""
+			if v.UUID != nil && resp.Status.Resources.HostReference != nil {
+				gpu["host_uuid"] = utils.StringValue(resp.Status.Resources.HostReference.UUID)
+			}
 
 			gpuList[k] = gpu
 		}
@@ -343,6 +546,10 @@ func resourceNutanixVirtualMachineRead(d *schema.ResourceData, meta interface{})
 		parentRef["kind"] = utils.StringValue(resp.Status.Resources.ParentReference.Kind)
 		parentRef["name"] = utils.StringValue(resp.Status.Resources.ParentReference.Name)
 		parentRef["uuid"] = utils.StringValue(resp.Status.Resources.ParentReference.UUID)
+	} else if source, ok := d.GetOk("clone_from_vm"); ok {
+		// Not every Prism version records the source of a clone, keep the lineage known to Terraform.
+		parentRef["kind"] = "vm"
+		parentRef["uuid"] = source.(string)
 	}
 	if err := d.Set("parent_reference", parentRef); err != nil {
 		return err
@@ -381,6 +588,21 @@ func resourceNutanixVirtualMachineRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("boot_device_mac_address", mac); err != nil {
 		return err
 	}
+	bootType := ""
+	if resp.Status.Resources.BootConfig != nil {
+		bootType = utils.StringValue(resp.Status.Resources.BootConfig.BootType)
+	}
+	if err := d.Set("boot_type", bootType); err != nil {
+		return err
+	}
+	if err := d.Set("machine_type", utils.StringValue(resp.Status.Resources.MachineType)); err != nil {
+		return err
+	}
+	bootOrder := flattenVMBootOrder(d, resp.Status.Resources.BootConfig, resp.Status.Resources.DiskList,
+		resp.Status.Resources.NicList)
+	if err := d.Set("boot_order", bootOrder); err != nil {
+		return err
+	}
 	// set hardware_clock_timezone value
 	if err := d.Set("hardware_clock_timezone", utils.StringValue(resp.Status.Resources.HardwareClockTimezone)); err != nil {
 		return err
@@ -401,13 +623,24 @@ func resourceNutanixVirtualMachineRead(d *schema.ResourceData, meta interface{})
 			sysprep["custom_key_values"] = resp.Status.Resources.GuestCustomization.Sysprep.CustomKeyValues
 		}
 	}
-	if err := d.Set("guest_customization_cloud_init", cloudInit); err != nil {
-		return err
+	// The maps hold the payloads as sent to the API, only keep them for configurations that still use them.
+	if _, ok := d.GetOk("guest_customization_cloud_init"); ok {
+		if err := d.Set("guest_customization_cloud_init", cloudInit); err != nil {
+			return err
+		}
 	}
 	if err := d.Set("guest_customization_is_overridable", isOverride); err != nil {
 		return err
 	}
-	if err := d.Set("guest_customization_sysprep", sysprep); err != nil {
+	if _, ok := d.GetOk("guest_customization_sysprep"); ok {
+		if err := d.Set("guest_customization_sysprep", sysprep); err != nil {
+			return err
+		}
+	}
+	if err := flattenGuestCustomizationHashes(d, resp.Status.Resources.GuestCustomization); err != nil {
+		return err
+	}
+	if err := d.Set("cdrom", flattenVMCdroms(d, resp.Status.Resources.DiskList)); err != nil {
 		return err
 	}
 	// set power_state_guest_transition_config value
@@ -425,56 +658,8 @@ func resourceNutanixVirtualMachineRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("vga_console_enabled", utils.BoolValue(resp.Status.Resources.VgaConsoleEnabled)); err != nil {
 		return err
 	}
-	diskList := make([]map[string]interface{}, 0)
-	if resp.Status.Resources.DiskList != nil {
-		diskList := make([]map[string]interface{}, len(resp.Status.Resources.DiskList))
-		for k, v := range resp.Status.Resources.DiskList {
-			disk := make(map[string]interface{})
-			disk["uuid"] = *v.UUID
-			disk["disk_size_bytes"] = *v.DiskSizeBytes
-			disk["disk_size_mib"] = *v.DiskSizeMib
-
-			ds := make([]map[string]interface{}, 1)
-			dsourceRef := make(map[string]interface{})
-			if v.DataSourceReference != nil {
-				dsourceRef["kind"] = utils.StringValue(v.DataSourceReference.Kind)
-				dsourceRef["name"] = utils.StringValue(v.DataSourceReference.Name)
-				dsourceRef["uuid"] = utils.StringValue(v.DataSourceReference.UUID)
-			}
-			ds[0] = dsourceRef
-
-			disk["data_source_reference"] = ds
-
-			vr := make([]map[string]interface{}, 1)
-			volumeRef := make(map[string]interface{})
-			if v.VolumeGroupReference != nil {
-				volumeRef["kind"] = utils.StringValue(v.VolumeGroupReference.Kind)
-				volumeRef["name"] = utils.StringValue(v.VolumeGroupReference.Name)
-				volumeRef["uuid"] = utils.StringValue(v.VolumeGroupReference.UUID)
-			}
-			vr[0] = volumeRef
-
-			disk["volume_group_reference"] = vr
-
-			dp := make([]map[string]interface{}, 1)
-			deviceProps := make(map[string]interface{})
-			deviceProps["device_type"] = utils.StringValue(v.DeviceProperties.DeviceType)
-			dp[0] = deviceProps
-
-			da := make([]map[string]interface{}, 1)
-			diskAddress := make(map[string]interface{})
-			if v.DeviceProperties.DiskAddress != nil {
-				diskAddress["device_index"] = utils.Int64Value(v.DeviceProperties.DiskAddress.DeviceIndex)
-				diskAddress["adapter_type"] = utils.StringValue(v.DeviceProperties.DiskAddress.AdapterType)
-			}
-			da[0] = diskAddress
-			deviceProps["disk_address"] = da
-
-			disk["device_properties"] = dp
-
-			diskList[k] = disk
-		}
-	}
+	managed := vmDiskListUUIDs(d, d.Get("disk_list").([]interface{}))
+	diskList := flattenVMDiskList(resp.Status.Resources.DiskList, managed, d.Get("cdrom").([]interface{}))
 	if err := d.Set("disk_list", diskList); err != nil {
 		return err
 	}
@@ -487,6 +672,13 @@ func resourceNutanixVirtualMachineRead(d *schema.ResourceData, meta interface{})
 func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*NutanixClient).API
 
+	vmMutexKV.Lock(d.Id())
+	defer vmMutexKV.Unlock(d.Id())
+
+	if err := checkVMBootConfig(d); err != nil {
+		return err
+	}
+
 	log.Printf("Updating VM values %s", d.Id())
 	fmt.Printf("Updating VM values %s", d.Id())
 
@@ -494,7 +686,6 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 	metadata := &v3.VMMetadata{}
 	res := &v3.VMResources{}
 	spec := &v3.VM{}
-	guest := &v3.GuestCustomization{}
 	guestTool := &v3.GuestToolsSpec{}
 	boot := &v3.VMBootConfig{}
 	pw := &v3.VMPowerStateMechanism{}
@@ -523,11 +714,7 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 
 	if d.HasChange("categories") {
 		p := d.Get("categories").(map[string]interface{})
-		labels := map[string]string{}
-		for k, v := range p {
-			labels[k] = v.(string)
-		}
-		metadata.Categories = labels
+		metadata.Categories = mergeDefaultCategories(meta.(*NutanixClient).DefaultCategories, p)
 	}
 	if d.HasChange("owner_reference") {
 		or := d.Get("owner_reference").(map[string]interface{})
@@ -540,12 +727,10 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 	}
 	if d.HasChange("project_reference") {
 		pr := d.Get("project_reference").(map[string]interface{})
-		r := &v3.Reference{
-			Kind: utils.String(pr["kind"].(string)),
-			UUID: utils.String(pr["uuid"].(string)),
-			Name: utils.String(pr["name"].(string)),
+		metadata.ProjectReference = expandReference(pr, "project")
+		if err := resolveProjectReference(conn, metadata.ProjectReference); err != nil {
+			return err
 		}
-		metadata.ProjectReference = r
 	}
 	if d.HasChange("name") {
 		spec.Name = utils.String(d.Get("name").(string))
@@ -553,27 +738,6 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("description") {
 		spec.Description = utils.String(d.Get("description").(string))
 	}
-	if d.HasChange("availability_zone_reference") {
-		a := d.Get("availability_zone_reference").(map[string]interface{})
-		r := &v3.Reference{
-			Kind: utils.String(a["kind"].(string)),
-			UUID: utils.String(a["uuid"].(string)),
-			Name: utils.String(a["name"].(string)),
-		}
-		spec.AvailabilityZoneReference = r
-	}
-	if d.HasChange("cluster_reference") {
-		a := d.Get("cluster_reference").(map[string]interface{})
-
-		utils.PrintToJSON(a, "CLUSTER VALUES =>")
-
-		r := &v3.Reference{
-			Kind: utils.String(a["kind"].(string)),
-			UUID: utils.String(a["uuid"].(string)),
-			Name: utils.String(a["name"].(string)),
-		}
-		spec.ClusterReference = r
-	}
 	if d.HasChange("parent_reference") {
 		a := d.Get("parent_reference").(map[string]interface{})
 		r := &v3.Reference{
@@ -607,12 +771,15 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 	if d.HasChange("hardware_clock_timezone") {
 		res.HardwareClockTimezone = utils.String(d.Get("hardware_clock_timezone").(string))
 	}
+	if d.HasChange("machine_type") {
+		res.MachineType = utils.String(d.Get("machine_type").(string))
+	}
+	if d.HasChange("boot_type") {
+		boot.BootType = utils.String(d.Get("boot_type").(string))
+	}
 	if d.HasChange("vga_console_enabled") {
 		res.VgaConsoleEnabled = utils.Bool(d.Get("vga_console_enabled").(bool))
 	}
-	if d.HasChange("guest_customization_is_overridable") {
-		guest.IsOverridable = utils.Bool(d.Get("guest_customization_is_overridable").(bool))
-	}
 	if d.HasChange("power_state_mechanism") {
 		pw.Mechanism = utils.String(d.Get("power_state_mechanism").(string))
 	}
@@ -623,26 +790,7 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 			ShouldFailOnScriptFailure: utils.Bool(val["should_fail_on_script_failure"].(bool)),
 		}
 	}
-	if d.HasChange("guest_customization_cloud_init") {
-		a := d.Get("guest_customization_cloud_init").(map[string]interface{})
-		r := &v3.GuestCustomizationCloudInit{
-			MetaData:        utils.String(a["meta_data"].(string)),
-			UserData:        utils.String(a["user_data"].(string)),
-			CustomKeyValues: a["custom_key_values"].(map[string]string),
-		}
-
-		guest.CloudInit = r
-	}
-	if d.HasChange("guest_customization_sysprep") {
-		a := d.Get("guest_customization_sysprep").(map[string]interface{})
-		r := &v3.GuestCustomizationSysprep{
-			InstallType:     utils.String(a["install_type"].(string)),
-			UnattendXML:     utils.String(a["unattend_xml"].(string)),
-			CustomKeyValues: a["custom_key_values"].(map[string]string),
-		}
-
-		guest.Sysprep = r
-	}
+	unmanagedNics := make(map[string]bool)
 	if d.HasChange("nic_list") {
 		n := d.Get("nic_list").([]interface{})
 		if len(n) > 0 {
@@ -691,6 +839,20 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 			}
 			res.NicList = nics
 		}
+
+		// NICs nic_list does not manage, like those attached with nutanix_vm_nic, stay attached.
+		o, _ := d.GetChange("nic_list")
+		managed := vmNicListUUIDs(d, o.([]interface{}))
+		resp, err := conn.V3.GetVM(d.Id())
+		if err != nil {
+			return err
+		}
+		for _, nic := range resp.Spec.Resources.NicList {
+			if uuid := utils.StringValue(nic.UUID); managed != nil && !managed[uuid] {
+				res.NicList = append(res.NicList, nic)
+				unmanagedNics[uuid] = true
+			}
+		}
 	}
 	if d.HasChange("nic_list") {
 		ngt := d.Get("nutanix_guest_tools").(map[string]interface{})
@@ -709,6 +871,10 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 		}
 		guestTool.NutanixGuestTools = tool
 	}
+	// The guest tools are sent with every update, so the ngt block stays in effect.
+	if _, ok := d.GetOk("ngt"); ok {
+		guestTool.NutanixGuestTools = expandVMNgt(d)
+	}
 	if d.HasChange("gpu_list") {
 		if v, ok := d.GetOk("gpu_list"); ok {
 			gpl := make([]*v3.VMGpu, len(v.([]interface{})))
@@ -727,6 +893,9 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 				}
 				gpl[k] = gpu
 			}
+			if err := resolveVMGpus(conn, d, gpl); err != nil {
+				return err
+			}
 			res.GpuList = gpl
 		}
 	}
@@ -756,6 +925,7 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 		bd.MacAddress = utils.String(v)
 	}
 
+	unmanagedDisks := make(map[string]bool)
 	if d.HasChange("disk_list") {
 		if v, ok := d.GetOk("disk_list"); ok {
 			dsk := v.([]interface{})
@@ -831,13 +1001,87 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 				res.DiskList = dls
 			}
 		}
+
+		// Disks disk_list does not manage, like those attached with nutanix_vm_disk or the CD-ROMs of the cdrom
+		// blocks, stay attached.
+		o, _ := d.GetChange("disk_list")
+		managed := vmDiskListUUIDs(d, o.([]interface{}))
+		oldCdroms, _ := d.GetChange("cdrom")
+		resp, err := conn.V3.GetVM(d.Id())
+		if err != nil {
+			return err
+		}
+		for _, disk := range resp.Spec.Resources.DiskList {
+			uuid := utils.StringValue(disk.UUID)
+			if (managed != nil && !managed[uuid]) || isCdromOf(disk, oldCdroms.([]interface{})) {
+				res.DiskList = append(res.DiskList, disk)
+				unmanagedDisks[uuid] = true
+			}
+		}
+	}
+
+	// Changes AHV cannot apply to a running VM are made with the VM powered off, as far as power_cycle_policy
+	// allows it, and the VM is powered on again afterwards with the power state mechanism it had.
+	powerCycled := false
+	var restoreMechanism *v3.VMPowerStateMechanism
+	if changes := vmColdPlugChanges(d); len(changes) > 0 {
+		resp, err := conn.V3.GetVM(d.Id())
+		if err != nil {
+			return err
+		}
+
+		if utils.StringValue(resp.Status.Resources.PowerState) == "ON" {
+			mechanism, err := powerCycleMechanism(d)
+			if err != nil {
+				return fmt.Errorf("changing %s of vm (%s) needs it powered off: %s",
+					strings.Join(changes, ", "), d.Id(), err)
+			}
+
+			log.Printf("[WARN] Powering off vm (%s) with mechanism %s to change %s",
+				d.Id(), mechanism, strings.Join(changes, ", "))
+			off := &v3.VMPowerStateMechanism{Mechanism: utils.String(mechanism)}
+			if restoreMechanism, err = setVMPowerState(conn, d.Id(), "OFF", off); err != nil {
+				return err
+			}
+			res.PowerState = utils.String("OFF")
+			powerCycled = true
+
+			// A mechanism changed by this update is the one to keep.
+			if pw.Mechanism != nil {
+				restoreMechanism.Mechanism = pw.Mechanism
+			}
+			if pw.GuestTransitionConfig != nil {
+				restoreMechanism.GuestTransitionConfig = pw.GuestTransitionConfig
+			}
+		}
+	}
+
+	// endPowerCycle powers the VM on again after it was powered off for the update, unless power_state keeps it
+	// off, and restores its power state mechanism.
+	endPowerCycle := func() error {
+		if !powerCycled {
+			return nil
+		}
+		if d.Get("power_state").(string) == "OFF" {
+			return restoreVMPowerStateMechanism(conn, d.Id(), restoreMechanism)
+		}
+		log.Printf("[WARN] Powering vm (%s) back on", d.Id())
+		_, err := setVMPowerState(conn, d.Id(), "ON", restoreMechanism)
+		return err
+	}
+
+	// An update failing after the VM was powered off for it powers the VM on again, as it was before.
+	restorePower := func(err error) error {
+		if perr := endPowerCycle(); perr != nil {
+			return fmt.Errorf("%s; powering the vm back on also failed: %s", err, perr)
+		}
+		return err
 	}
 
 	boot.BootDevice = bd
 	res.PowerStateMechanism = pw
 	res.BootConfig = boot
 	res.GuestTools = guestTool
-	res.GuestCustomization = guest
 	spec.Resources = res
 	request.Metadata = metadata
 	request.Spec = spec
@@ -848,7 +1092,7 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 	utils.PrintToJSON(request, "UPDATE")
 	_, err := conn.V3.UpdateVM(d.Id(), request)
 	if err != nil {
-		return err
+		return restorePower(err)
 	}
 
 	stateConf := &resource.StateChangeConf{
@@ -861,13 +1105,233 @@ func resourceNutanixVirtualMachineUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf(
-			"Error waiting for vm (%s) to update: %s", d.Id(), err)
+		return restorePower(fmt.Errorf(
+			"Error waiting for vm (%s) to update: %s", d.Id(), err))
+	}
+
+	// Read tells the disks disk_list manages by their UUID, which new disks only have now.
+	if d.HasChange("disk_list") {
+		resp, err := conn.V3.GetVM(d.Id())
+		if err != nil {
+			return restorePower(err)
+		}
+		managed := make(map[string]bool)
+		for _, disk := range resp.Status.Resources.DiskList {
+			if uuid := utils.StringValue(disk.UUID); !unmanagedDisks[uuid] {
+				managed[uuid] = true
+			}
+		}
+		diskList := flattenVMDiskList(resp.Status.Resources.DiskList, managed, d.Get("cdrom").([]interface{}))
+		if err := d.Set("disk_list", diskList); err != nil {
+			return restorePower(err)
+		}
+	}
+
+	// Read tells the NICs nic_list manages by their UUID, which new NICs only have now.
+	if d.HasChange("nic_list") {
+		resp, err := conn.V3.GetVM(d.Id())
+		if err != nil {
+			return restorePower(err)
+		}
+		managed := make(map[string]bool)
+		for _, nic := range resp.Status.Resources.NicList {
+			if uuid := utils.StringValue(nic.UUID); !unmanagedNics[uuid] {
+				managed[uuid] = true
+			}
+		}
+		if err := d.Set("nic_list", flattenVMNicList(resp.Status.Resources.NicList, managed)); err != nil {
+			return restorePower(err)
+		}
+	}
+
+	// ISOs are inserted, swapped and ejected on the current spec, so the change works on a running VM.
+	if d.HasChange("cdrom") {
+		o, n := d.GetChange("cdrom")
+		err := updateVMSpec(conn, d.Id(), func(spec *v3.VM) error {
+			disks, err := syncVMCdroms(conn, spec.Resources.DiskList, o.([]interface{}), n.([]interface{}))
+			spec.Resources.DiskList = disks
+			return err
+		})
+		if err != nil {
+			return restorePower(fmt.Errorf("Error updating cdroms of vm (%s): %s", d.Id(), err))
+		}
+	}
+
+	// boot_order references devices by position and address, so it is resolved again when they change.
+	if _, ok := d.GetOk("boot_order"); ok &&
+		(d.HasChange("boot_order") || d.HasChange("disk_list") || d.HasChange("nic_list") || d.HasChange("cdrom")) {
+		err := updateVMSpec(conn, d.Id(), func(spec *v3.VM) error {
+			return applyVMBootConfig(d, spec)
+		})
+		if err != nil {
+			return restorePower(fmt.Errorf("Error updating the boot order of vm (%s): %s", d.Id(), err))
+		}
+	}
+
+	if err := endPowerCycle(); err != nil {
+		return err
+	}
+
+	// VSS snapshots go through NGT, so the update waits until the guest talks to the cluster again.
+	if (d.HasChange("ngt") || powerCycled) && ngtWaitForCommunication(d) {
+		if err := waitForVMNgtCommunication(conn, d.Id()); err != nil {
+			return err
+		}
 	}
 
 	return resourceNutanixVirtualMachineRead(d, meta)
 }
 
+// vmColdPlugChanges returns the changed arguments that cannot be applied while the VM is running. AHV hot adds
+// sockets and memory, but removing them or changing the CPU topology or firmware needs the VM powered off.
+func vmColdPlugChanges(d *schema.ResourceData) []string {
+	var changes []string
+
+	for _, k := range []string{"num_sockets", "memory_size_mib"} {
+		if o, n := d.GetChange(k); n.(int) < o.(int) {
+			changes = append(changes, k)
+		}
+	}
+	for _, k := range []string{"num_vcpus_per_socket", "num_vnuma_nodes", "gpu_list", "vga_console_enabled",
+		"boot_type", "machine_type"} {
+		if d.HasChange(k) {
+			changes = append(changes, k)
+		}
+	}
+
+	return changes
+}
+
+// powerCycleMechanism returns the power_state_mechanism used to power off the VM for a change, or an error when
+// power_cycle_policy does not allow powering it off.
+func powerCycleMechanism(d *schema.ResourceData) (string, error) {
+	switch d.Get("power_cycle_policy").(string) {
+	case "allow_shutdown_guest":
+		if m := d.Get("power_state_mechanism").(string); m == "ACPI" || m == "GUEST" {
+			return m, nil
+		}
+		return "ACPI", nil
+	case "allow_hard_off":
+		return "HARD", nil
+	}
+	return "", fmt.Errorf("power_cycle_policy is fail, power the vm off or allow a shutdown")
+}
+
+// validatePowerCyclePolicy warns on every plan using a policy other than fail that updates may restart the VM.
+// Whether an update needs the restart is only known when it is applied, so the warning does not mean this plan
+// restarts the VM.
+func validatePowerCyclePolicy(v interface{}, k string) (ws []string, es []error) {
+	ws, es = validateOneOf("fail", "allow_shutdown_guest", "allow_hard_off")(v, k)
+	switch v.(string) {
+	case "allow_shutdown_guest":
+		ws = append(ws, fmt.Sprintf("%q: the VM is shut down and restarted for any change that cannot be hot plugged, "+
+			"this is shown on every plan whether or not it changes such an argument", k))
+	case "allow_hard_off":
+		ws = append(ws, fmt.Sprintf("%q: the VM is powered off without a guest shutdown and restarted for any change "+
+			"that cannot be hot plugged, this is shown on every plan whether or not it changes such an argument", k))
+	}
+	return
+}
+
+// setVMPowerState changes the power state of a VM with the given power state mechanism, or the one of the VM
+// when it is nil, and waits for the VM to reach the state. The mechanism is written to the spec of the VM, so
+// the one it replaced is returned for the caller to put back with restoreVMPowerStateMechanism or the next
+// setVMPowerState.
+func setVMPowerState(conn *v3.Client, uuid, state string, mechanism *v3.VMPowerStateMechanism) (*v3.VMPowerStateMechanism, error) {
+	previous := &v3.VMPowerStateMechanism{}
+	err := updateVMSpec(conn, uuid, func(spec *v3.VM) error {
+		if spec.Resources.PowerStateMechanism != nil {
+			previous = spec.Resources.PowerStateMechanism
+		}
+		spec.Resources.PowerState = utils.String(state)
+		if mechanism != nil {
+			spec.Resources.PowerStateMechanism = mechanism
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error changing power state of vm (%s) to %s: %s", uuid, state, err)
+	}
+
+	// A guest shutdown completes the spec before the guest has actually stopped.
+	pending := []string{"ON", "PAUSED", "SUSPENDED"}
+	if state == "ON" {
+		pending = []string{"OFF", "PAUSED", "SUSPENDED"}
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{state},
+		Refresh:    vmPowerStateRefreshFunc(conn, uuid),
+		Timeout:    10 * time.Minute,
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return nil, fmt.Errorf(
+			"Error waiting for vm (%s) to power %s: %s", uuid, strings.ToLower(state), err)
+	}
+
+	return previous, nil
+}
+
+// restoreVMPowerStateMechanism puts back the power state mechanism a setVMPowerState replaced, for a VM that
+// stays in the power state it was put in.
+func restoreVMPowerStateMechanism(conn *v3.Client, uuid string, mechanism *v3.VMPowerStateMechanism) error {
+	err := updateVMSpec(conn, uuid, func(spec *v3.VM) error {
+		spec.Resources.PowerStateMechanism = mechanism
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error restoring the power state mechanism of vm (%s): %s", uuid, err)
+	}
+	return nil
+}
+
+// updateVMSpec changes the spec of a VM by read-modify-write and waits for the change to apply. Resources
+// changing a VM they don't own hold its lock in vmMutexKV around the call.
+func updateVMSpec(conn *v3.Client, uuid string, modify func(spec *v3.VM) error) error {
+	resp, err := conn.V3.GetVM(uuid)
+	if err != nil {
+		return err
+	}
+
+	if err := modify(resp.Spec); err != nil {
+		return err
+	}
+
+	if _, err := conn.V3.UpdateVM(uuid, &v3.VMIntentInput{Metadata: resp.Metadata, Spec: resp.Spec}); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    vmStateRefreshFunc(conn, uuid),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for vm (%s) to update: %s", uuid, err)
+	}
+
+	return nil
+}
+
+func vmPowerStateRefreshFunc(client *v3.Client, uuid string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := client.V3.GetVM(uuid)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return v, utils.StringValue(v.Status.Resources.PowerState), nil
+	}
+}
+
 func resourceNutanixVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*NutanixClient).API
 
@@ -912,7 +1376,7 @@ func resourceNutanixVirtualMachineExists(d *schema.ResourceData, meta interface{
 	return false, nil
 }
 
-func getMetadaAttributes(d *schema.ResourceData, metadata *v3.VMMetadata) error {
+func getMetadaAttributes(d *schema.ResourceData, metadata *v3.VMMetadata, defaults map[string]string) error {
 	m, mok := d.GetOk("metadata")
 	metad := m.(map[string]interface{})
 
@@ -938,25 +1402,12 @@ func getMetadaAttributes(d *schema.ResourceData, metadata *v3.VMMetadata) error
 	if v, ok := metad["name"]; ok {
 		metadata.Name = utils.String(v.(string))
 	}
-	if v, ok := d.GetOk("categories"); ok {
-		c := v.(map[string]interface{})
-		labels := map[string]string{}
-
-		for k, v := range c {
-			labels[k] = v.(string)
-		}
-		metadata.Categories = labels
+	if v, ok := d.GetOk("categories"); ok || len(defaults) > 0 {
+		c, _ := v.(map[string]interface{})
+		metadata.Categories = mergeDefaultCategories(defaults, c)
 	}
 	if p, ok := d.GetOk("project_reference"); ok {
-		pr := p.(map[string]interface{})
-		r := &v3.Reference{
-			Kind: utils.String(pr["kind"].(string)),
-			UUID: utils.String(pr["uuid"].(string)),
-		}
-		if v1, ok1 := pr["name"]; ok1 {
-			r.Name = utils.String(v1.(string))
-		}
-		metadata.ProjectReference = r
+		metadata.ProjectReference = expandReference(p.(map[string]interface{}), "project")
 	}
 	if o, ok := metad["owner_reference"]; ok {
 		or := o.(map[string]interface{})
@@ -1161,10 +1612,20 @@ func getVMResources(d *schema.ResourceData, vm *v3.VMResources) error {
 			vm.GuestCustomization.CloudInit.CustomKeyValues = v2.(map[string]string)
 		}
 	}
+	if err := expandGuestCustomization(d, vm); err != nil {
+		return err
+	}
 	if v, ok := d.GetOk("guest_customization_is_overridable"); ok {
+		if vm.GuestCustomization == nil {
+			vm.GuestCustomization = &v3.GuestCustomization{}
+		}
 		vm.GuestCustomization.IsOverridable = utils.Bool(v.(bool))
 	}
 	if v, ok := d.GetOk("guest_customization_sysprep"); ok {
+		if vm.GuestCustomization == nil {
+			vm.GuestCustomization = &v3.GuestCustomization{}
+		}
+		vm.GuestCustomization.Sysprep = &v3.GuestCustomizationSysprep{}
 		spi := v.(map[string]interface{})
 		if v2, ok2 := spi["install_type"]; ok2 {
 			vm.GuestCustomization.Sysprep.InstallType = utils.String(v2.(string))
@@ -1282,6 +1743,22 @@ func vmStateRefreshFunc(client *v3.Client, uuid string) resource.StateRefreshFun
 	}
 }
 
+func taskStateRefreshFunc(client *v3.Client, uuid string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		t, err := client.V3.GetTask(uuid)
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := utils.StringValue(t.Status)
+		if status == "FAILED" || status == "ABORTED" {
+			return t, status, fmt.Errorf("task %s %s: %s", uuid, strings.ToLower(status), utils.StringValue(t.ErrorDetail))
+		}
+
+		return t, status, nil
+	}
+}
+
 func waitForIP(conn *v3.Client, uuid string, d *schema.ResourceData) error {
 	for {
 		resp, err := conn.V3.GetVM(uuid)
@@ -1350,9 +1827,10 @@ func getVMSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"project_reference": {
-			Type:     schema.TypeMap,
-			Optional: true,
-			Computed: true,
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppressReferenceDiff,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"kind": {
@@ -1409,9 +1887,11 @@ func getVMSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 		"availability_zone_reference": {
-			Type:     schema.TypeMap,
-			Optional: true,
-			Computed: true,
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppressReferenceDiff,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"kind": {
@@ -1431,9 +1911,11 @@ func getVMSchema() map[string]*schema.Schema {
 			},
 		},
 		"cluster_reference": {
-			Type:     schema.TypeMap,
-			Optional: true,
-			Computed: true,
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppressReferenceDiff,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"kind": {
@@ -1605,6 +2087,7 @@ func getVMSchema() map[string]*schema.Schema {
 			Optional: true,
 			Computed: true,
 		},
+		"ngt": getVMNgtSchema(),
 		"nutanix_guest_tools": {
 			Type:     schema.TypeMap,
 			Optional: true,
@@ -1675,16 +2158,22 @@ func getVMSchema() map[string]*schema.Schema {
 						Computed: true,
 					},
 					"vendor": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validateOneOf("NVIDIA", "INTEL", "AMD"),
+					},
+					"uuid": {
 						Type:     schema.TypeString,
-						Optional: true,
 						Computed: true,
 					},
-					"uuid": {
+					"host_uuid": {
 						Type:     schema.TypeString,
 						Computed: true,
 					},
 					"name": {
 						Type:     schema.TypeString,
+						Optional: true,
 						Computed: true,
 					},
 					"pci_address": {
@@ -1696,9 +2185,10 @@ func getVMSchema() map[string]*schema.Schema {
 						Computed: true,
 					},
 					"mode": {
-						Type:     schema.TypeString,
-						Optional: true,
-						Computed: true,
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validateOneOf("PASSTHROUGH_GRAPHICS", "PASSTHROUGH_COMPUTE", "VIRTUAL"),
 					},
 					"num_virtual_display_heads": {
 						Type:     schema.TypeInt,
@@ -1716,6 +2206,14 @@ func getVMSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"cdrom": getVMCdromSchema(),
+		"clone_from_vm": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"disk_list"},
+			ValidateFunc:  validateUUID,
+		},
 		"parent_reference": {
 			Type:     schema.TypeMap,
 			Optional: true,
@@ -1773,6 +2271,27 @@ func getVMSchema() map[string]*schema.Schema {
 			Optional: true,
 			Computed: true,
 		},
+		"boot_order": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"boot_device_order_list", "boot_device_disk_address", "boot_device_mac_address"},
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateBootReference,
+			},
+		},
+		"boot_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateOneOf("LEGACY", "UEFI", "SECURE_BOOT"),
+		},
+		"machine_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateOneOf("PC", "PSERIES", "Q35"),
+		},
 		"hardware_clock_timezone": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -1782,6 +2301,7 @@ func getVMSchema() map[string]*schema.Schema {
 			Type:     schema.TypeMap,
 			Optional: true,
 			Computed: true,
+			ForceNew: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"meta_data": {
@@ -1802,15 +2322,164 @@ func getVMSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"user_data": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			Sensitive:     true,
+			StateFunc:     hashGuestCustomization,
+			ValidateFunc:  validateUserData,
+			ConflictsWith: []string{"cloud_init", "guest_customization_cloud_init", "unattend_xml", "guest_customization_sysprep"},
+		},
+		"meta_data": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			Sensitive:     true,
+			StateFunc:     hashGuestCustomization,
+			ValidateFunc:  validateMetaData,
+			ConflictsWith: []string{"guest_customization_cloud_init", "unattend_xml", "guest_customization_sysprep"},
+		},
+		"cloud_init": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			ForceNew:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"user_data", "guest_customization_cloud_init", "unattend_xml", "guest_customization_sysprep"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hostname": {
+						Type:     schema.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+					"ssh_authorized_keys": {
+						Type:      schema.TypeList,
+						Optional:  true,
+						ForceNew:  true,
+						Sensitive: true,
+						Elem:      &schema.Schema{Type: schema.TypeString, StateFunc: hashGuestCustomization},
+					},
+					"keep_default_user": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+					"users": {
+						Type:     schema.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:     schema.TypeString,
+									Required: true,
+									ForceNew: true,
+								},
+								"groups": {
+									Type:     schema.TypeList,
+									Optional: true,
+									ForceNew: true,
+									Elem:     &schema.Schema{Type: schema.TypeString},
+								},
+								"sudo": {
+									Type:     schema.TypeString,
+									Optional: true,
+									ForceNew: true,
+								},
+								"shell": {
+									Type:     schema.TypeString,
+									Optional: true,
+									ForceNew: true,
+								},
+								"lock_passwd": {
+									Type:     schema.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+								"ssh_authorized_keys": {
+									Type:      schema.TypeList,
+									Optional:  true,
+									ForceNew:  true,
+									Sensitive: true,
+									Elem:      &schema.Schema{Type: schema.TypeString, StateFunc: hashGuestCustomization},
+								},
+							},
+						},
+					},
+					"write_files": {
+						Type:     schema.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"path": {
+									Type:     schema.TypeString,
+									Required: true,
+									ForceNew: true,
+								},
+								"content": {
+									Type:      schema.TypeString,
+									Required:  true,
+									ForceNew:  true,
+									Sensitive: true,
+									StateFunc: hashGuestCustomization,
+								},
+								"permissions": {
+									Type:     schema.TypeString,
+									Optional: true,
+									ForceNew: true,
+								},
+								"owner": {
+									Type:     schema.TypeString,
+									Optional: true,
+									ForceNew: true,
+								},
+							},
+						},
+					},
+					"runcmd": {
+						Type:      schema.TypeList,
+						Optional:  true,
+						ForceNew:  true,
+						Sensitive: true,
+						Elem:      &schema.Schema{Type: schema.TypeString, StateFunc: hashGuestCustomization},
+					},
+				},
+			},
+		},
+		"unattend_xml": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			Sensitive:     true,
+			StateFunc:     hashGuestCustomization,
+			ValidateFunc:  validateUnattendXML,
+			ConflictsWith: []string{"user_data", "meta_data", "cloud_init", "guest_customization_cloud_init", "guest_customization_sysprep"},
+		},
+		"sysprep_install_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validateOneOf("FRESH", "PREPARED"),
+		},
 		"guest_customization_is_overridable": {
 			Type:     schema.TypeBool,
 			Optional: true,
 			Computed: true,
+			ForceNew: true,
 		},
 		"guest_customization_sysprep": {
 			Type:     schema.TypeMap,
 			Optional: true,
 			Computed: true,
+			ForceNew: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"install_type": {
@@ -1841,6 +2510,12 @@ func getVMSchema() map[string]*schema.Schema {
 			Optional: true,
 			Computed: true,
 		},
+		"power_cycle_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "fail",
+			ValidateFunc: validatePowerCyclePolicy,
+		},
 		"power_state_mechanism": {
 			Type:     schema.TypeString,
 			Optional: true,
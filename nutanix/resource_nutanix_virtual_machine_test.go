@@ -2,13 +2,17 @@ package nutanix
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
 func TestAccNutanixVirtualMachine_basic(t *testing.T) {
@@ -28,12 +32,329 @@ func TestAccNutanixVirtualMachine_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm1", "memory_size_mib", "2048"),
 					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm1", "num_sockets", "1"),
 					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm1", "num_vcpus_per_socket", "1"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm1", "ngt.#", "0"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccNutanixVirtualMachine_clone(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixVMConfigClone(r),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVirtualMachineExists("nutanix_virtual_machine.clone"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.clone", "name", fmt.Sprintf("test-dou-clone-%d", r)),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.clone", "memory_size_mib", "4096"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.clone", "num_sockets", "2"),
+					resource.TestCheckResourceAttrPair(
+						"nutanix_virtual_machine.clone", "parent_reference.uuid", "nutanix_virtual_machine.template", "id"),
+					resource.TestCheckResourceAttrPair(
+						"nutanix_virtual_machine.clone", "project_reference.uuid", "nutanix_project.clone", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNutanixVirtualMachine_cloneDiskList(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNutanixVMConfigCloneDiskList(r),
+				ExpectError: regexp.MustCompile("conflicts with"),
+			},
+		},
+	})
+}
+
+func TestAccNutanixVirtualMachine_cloudInit(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNutanixVMConfigUserData(r, "#cloud-config\\nruncmd: [\\n"),
+				ExpectError: regexp.MustCompile("not a valid #cloud-config document"),
+			},
+			{
+				Config: testAccNutanixVMConfigCloudInit(r),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVirtualMachineExists("nutanix_virtual_machine.vm3"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm3", "cloud_init.0.hostname", fmt.Sprintf("test-dou-%d", r)),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm3", "cloud_init.0.users.0.name", "ops"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm3", "meta_data",
+						hashGuestCustomization(fmt.Sprintf("instance-id: test-dou-%d\n", r))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNutanixVirtualMachine_guestCustomizationForcesNew(t *testing.T) {
+	r := acctest.RandInt()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixVMConfigOverridable(r, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVirtualMachineExists("nutanix_virtual_machine.vm4"),
+					testAccCheckNutanixVirtualMachineID("nutanix_virtual_machine.vm4", &id),
+				),
+			},
+			{
+				Config: testAccNutanixVMConfigOverridable(r, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm4", "guest_customization_is_overridable", "true"),
+					testAccCheckNutanixVirtualMachineRecreated("nutanix_virtual_machine.vm4", &id),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNutanixVirtualMachine_powerCyclePolicy(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixVMConfigPowerCycle(r, 1, "fail"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVirtualMachineExists("nutanix_virtual_machine.vm5"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm5", "power_state", "ON"),
+				),
+			},
+			{
+				Config:      testAccNutanixVMConfigPowerCycle(r, 2, "fail"),
+				ExpectError: regexp.MustCompile("needs it powered off"),
+			},
+			{
+				Config: testAccNutanixVMConfigPowerCycle(r, 2, "allow_hard_off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm5", "num_vcpus_per_socket", "2"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm5", "power_state", "ON"),
+					testAccCheckNutanixVMPowerStateMechanism("nutanix_virtual_machine.vm5", "ACPI"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNutanixVirtualMachine_cdrom(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixVMConfigCdrom(r, "ISO_IMAGE", `image_name = "${nutanix_image.iso.name}"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVirtualMachineExists("nutanix_virtual_machine.vm6"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm6", "cdrom.#", "1"),
+					resource.TestCheckResourceAttrSet("nutanix_virtual_machine.vm6", "cdrom.0.uuid"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm6", "disk_list.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"nutanix_virtual_machine.vm6", "cdrom.0.image_name", "nutanix_image.iso", "name"),
+				),
+			},
+			{
+				Config: testAccNutanixVMConfigCdrom(r, "ISO_IMAGE", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm6", "cdrom.#", "1"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm6", "cdrom.0.image_name", ""),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm6", "cdrom.0.image_id", ""),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm6", "disk_list.#", "1"),
+				),
+			},
+			{
+				Config:      testAccNutanixVMConfigCdrom(r, "DISK_IMAGE", `image_id = "${nutanix_image.iso.id}"`),
+				ExpectError: regexp.MustCompile("only ISO_IMAGE images can be inserted"),
+			},
+		},
+	})
+}
+
+func TestAccNutanixVirtualMachine_bootConfig(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNutanixVMConfigBoot(r, "SECURE_BOOT", "PC", `"disk:0"`),
+				ExpectError: regexp.MustCompile("needs machine_type Q35"),
+			},
+			{
+				Config:      testAccNutanixVMConfigBoot(r, "UEFI", "Q35", `"disk:SCSI.3"`),
+				ExpectError: regexp.MustCompile("disk:SCSI.3 is not in disk_list"),
+			},
+			{
+				Config: testAccNutanixVMConfigBoot(r, "UEFI", "Q35", `"disk:0", "CDROM"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVirtualMachineExists("nutanix_virtual_machine.vm7"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm7", "boot_type", "UEFI"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm7", "machine_type", "Q35"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm7", "boot_order.#", "2"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm7", "boot_device_disk_address.adapter_type", "SCSI"),
+				),
+			},
+			{
+				Config: testAccNutanixVMConfigBoot(r, "SECURE_BOOT", "Q35", `"disk:IDE.0", "DISK"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm7", "boot_type", "SECURE_BOOT"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm7", "boot_order.0", "disk:IDE.0"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm7", "boot_device_order_list.0", "CDROM"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNutanixVirtualMachine_ngt(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNutanixVMConfigNgt(r, false, "FILE_LEVEL_RESTORE"),
+				ExpectError: regexp.MustCompile("must be one of SELF_SERVICE_RESTORE, VSS_SNAPSHOT"),
+			},
+			{
+				Config: testAccNutanixVMConfigNgt(r, true, "VSS_SNAPSHOT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVirtualMachineExists("nutanix_virtual_machine.vm8"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm8", "ngt.0.enabled", "true"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm8", "ngt.0.iso_mounted", "true"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm8", "ngt.0.capabilities.#", "1"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm8",
+						fmt.Sprintf("ngt.0.capabilities.%d", schema.HashString("VSS_SNAPSHOT")), "VSS_SNAPSHOT"),
+					resource.TestCheckResourceAttrSet("nutanix_virtual_machine.vm8", "ngt.0.available_version"),
+				),
+			},
+			{
+				Config: testAccNutanixVMConfigNgt(r, false, "SELF_SERVICE_RESTORE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm8", "ngt.0.iso_mounted", "false"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm8", "ngt.0.capabilities.#", "1"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm8",
+						fmt.Sprintf("ngt.0.capabilities.%d", schema.HashString("SELF_SERVICE_RESTORE")), "SELF_SERVICE_RESTORE"),
+				),
+			},
+			{
+				Config: testAccNutanixVMConfigNgt(r, false, "VSS_SNAPSHOT", "SELF_SERVICE_RESTORE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm8", "ngt.0.capabilities.#", "2"),
+				),
+			},
+			{
+				Config: testAccNutanixVMConfigNgtBlock(r, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm8", "ngt.#", "0"),
+					testAccCheckNutanixVMNgtState("nutanix_virtual_machine.vm8", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNutanixVirtualMachine_gpuUnknownProfile(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNutanixVMConfigGpu(r, "NVIDIA", "GRID no-such-profile"),
+				ExpectError: regexp.MustCompile(`no NVIDIA GPU named "GRID no-such-profile"`),
+			},
+		},
+	})
+}
+
+func TestAccNutanixVirtualMachine_gpuProfile(t *testing.T) {
+	profile := os.Getenv("NUTANIX_GPU_PROFILE")
+	if profile == "" {
+		t.Skip("NUTANIX_GPU_PROFILE must be set to a GPU model or vGPU profile of the cluster for GPU acceptance tests")
+	}
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixVMConfigGpu(r, "NVIDIA", profile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVirtualMachineExists("nutanix_virtual_machine.vm9"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm9", "gpu_list.0.name", profile),
+					resource.TestCheckResourceAttrSet("nutanix_virtual_machine.vm9", "gpu_list.0.device_id"),
+					resource.TestCheckResourceAttrSet("nutanix_virtual_machine.vm9", "gpu_list.0.uuid"),
+					resource.TestCheckResourceAttrSet("nutanix_virtual_machine.vm9", "gpu_list.0.host_uuid"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNutanixVirtualMachineID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*id = rs.Primary.ID
+
+		return nil
+	}
+}
+
+func testAccCheckNutanixVirtualMachineRecreated(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == *id {
+			return fmt.Errorf("expected %s to be replaced, it kept id %s", n, *id)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckNutanixVirtualMachineExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -48,6 +369,33 @@ func testAccCheckNutanixVirtualMachineExists(n string) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckNutanixVMNgtState checks the NGT state in the spec of a VM, which the ngt block no longer sets once
+// it is removed.
+func testAccCheckNutanixVMNgtState(n, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*NutanixClient)
+
+		resp, err := conn.API.V3.GetVM(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		state := ""
+		if tools := resp.Spec.Resources.GuestTools; tools != nil && tools.NutanixGuestTools != nil {
+			state = utils.StringValue(tools.NutanixGuestTools.State)
+		}
+		if state != expected {
+			return fmt.Errorf("NGT of vm %s is %q, expected %q", rs.Primary.ID, state, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckNutanixVirtualMachineDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*NutanixClient)
 
@@ -106,3 +454,375 @@ resource "nutanix_virtual_machine" "vm1" {
 }
 `)
 }
+
+func testAccNutanixVMConfigClone(r int) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "template" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-template-%[1]d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "OFF"
+}
+
+resource "nutanix_project" "clone" {
+  name = "test-dou-clone-%[1]d"
+}
+
+resource "nutanix_virtual_machine" "clone" {
+  metadata {
+    kind = "vm"
+  }
+
+  name          = "test-dou-clone-%[1]d"
+  clone_from_vm = "${nutanix_virtual_machine.template.id}"
+
+  project_reference = {
+    name = "${nutanix_project.clone.name}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 2
+  memory_size_mib      = 4096
+}
+`, r)
+}
+
+func testAccNutanixVMConfigCloneDiskList(r int) string {
+	return fmt.Sprintf(`
+resource "nutanix_virtual_machine" "clone" {
+  metadata {
+    kind = "vm"
+  }
+
+  name          = "test-dou-clone-%d"
+  clone_from_vm = "000567f3-1921-c722-471d-0cc47ac31055"
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+
+  disk_list = [
+    {
+      disk_size_mib = 10240
+    },
+  ]
+}
+`, r)
+}
+
+func testAccNutanixVMConfigUserData(r int, userData string) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "vm3" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "OFF"
+
+  user_data = "%s"
+}
+`, r, userData)
+}
+
+func testAccNutanixVMConfigCloudInit(r int) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "vm3" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%[1]d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "OFF"
+
+  meta_data = "instance-id: test-dou-%[1]d\n"
+
+  cloud_init {
+    hostname = "test-dou-%[1]d"
+
+    users {
+      name        = "ops"
+      groups      = ["wheel"]
+      sudo        = "ALL=(ALL) NOPASSWD:ALL"
+      lock_passwd = false
+    }
+
+    write_files {
+      path        = "/etc/motd"
+      content     = "managed by terraform\n"
+      permissions = "0644"
+    }
+
+    runcmd = ["systemctl restart sshd"]
+  }
+}
+`, r)
+}
+
+func testAccNutanixVMConfigOverridable(r int, overridable bool) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "vm4" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "OFF"
+
+  meta_data                          = "instance-id: test-dou\n"
+  guest_customization_is_overridable = %t
+}
+`, r, overridable)
+}
+
+func testAccNutanixVMConfigPowerCycle(r, vcpus int, policy string) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "vm5" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket  = %d
+  num_sockets           = 1
+  memory_size_mib       = 2048
+  power_state           = "ON"
+  power_state_mechanism = "ACPI"
+  power_cycle_policy    = "%s"
+}
+`, r, vcpus, policy)
+}
+
+func testAccNutanixVMConfigCdrom(r int, imageType, image string) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_image" "iso" {
+  name        = "Ubuntu-%[1]d-%[2]s"
+  description = "Ubuntu"
+  image_type  = "%[2]s"
+  source_uri  = "http://archive.ubuntu.com/ubuntu/dists/bionic/main/installer-amd64/current/images/netboot/mini.iso"
+
+  metadata = {
+    kind = "image"
+  }
+}
+
+resource "nutanix_virtual_machine" "vm6" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%[1]d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "ON"
+
+  disk_list = [
+    {
+      disk_size_mib = 10240
+    },
+  ]
+
+  cdrom {
+    %[3]s
+  }
+}
+`, r, imageType, image)
+}
+
+func testAccNutanixVMConfigBoot(r int, bootType, machineType, bootOrder string) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "vm7" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "OFF"
+  boot_type            = "%s"
+  machine_type         = "%s"
+  boot_order           = [%s]
+
+  disk_list = [
+    {
+      disk_size_mib = 10240
+
+      device_properties = [
+        {
+          device_type = "DISK"
+
+          disk_address = [
+            {
+              adapter_type = "SCSI"
+              device_index = 0
+            },
+          ]
+        },
+      ]
+    },
+  ]
+
+  cdrom {}
+}
+`, r, bootType, machineType, bootOrder)
+}
+
+func testAccNutanixVMConfigNgt(r int, mounted bool, capabilities ...string) string {
+	return testAccNutanixVMConfigNgtBlock(r, fmt.Sprintf(`
+  ngt {
+    iso_mounted  = %t
+    capabilities = ["%s"]
+
+    # The test VM has no guest OS to install NGT in.
+    wait_for_communication = false
+  }
+`, mounted, strings.Join(capabilities, `", "`)))
+}
+
+func testAccNutanixVMConfigNgtBlock(r int, ngt string) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "vm8" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "ON"
+
+  cdrom {}
+%s}
+`, r, ngt)
+}
+
+func testAccNutanixVMConfigGpu(r int, vendor, profile string) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "vm9" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "ON"
+
+  gpu_list = [
+    {
+      vendor = "%s"
+      name   = "%s"
+    },
+  ]
+}
+`, r, vendor, profile)
+}
@@ -0,0 +1,365 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNutanixVMDisk() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNutanixVMDiskCreate,
+		Read:   resourceNutanixVMDiskRead,
+		Update: resourceNutanixVMDiskUpdate,
+		Delete: resourceNutanixVMDiskDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNutanixVMDiskImport,
+		},
+		Schema: getVMDiskSchema(),
+	}
+}
+
+func resourceNutanixVMDiskCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	vmID := d.Get("vm_id").(string)
+	adapter := d.Get("adapter_type").(string)
+
+	vmMutexKV.Lock(vmID)
+	defer vmMutexKV.Unlock(vmID)
+
+	disk := &v3.VMDisk{
+		DeviceProperties: &v3.VMDiskDeviceProperties{
+			DeviceType:  utils.String("DISK"),
+			DiskAddress: &v3.DiskAddress{AdapterType: utils.String(adapter)},
+		},
+	}
+	if v, ok := d.GetOk("disk_size_mib"); ok {
+		disk.DiskSizeMib = utils.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("image_id"); ok {
+		disk.DataSourceReference = &v3.Reference{Kind: utils.String("image"), UUID: utils.String(v.(string))}
+	}
+	if v, ok := d.GetOk("volume_group_id"); ok {
+		disk.VolumeGroupReference = &v3.Reference{Kind: utils.String("volume_group"), UUID: utils.String(v.(string))}
+		disk.DeviceProperties = nil
+	}
+	if disk.DiskSizeMib == nil && disk.DataSourceReference == nil && disk.VolumeGroupReference == nil {
+		return fmt.Errorf("one of disk_size_mib, image_id or volume_group_id must be set")
+	}
+
+	log.Printf("[DEBUG] Attaching disk to vm (%s)", vmID)
+
+	err := updateVMSpec(conn, vmID, func(spec *v3.VM) error {
+		if disk.DeviceProperties != nil {
+			index := int64(d.Get("device_index").(int))
+			if index < 0 {
+				index = nextDiskIndex(spec.Resources.DiskList, adapter)
+			}
+			if findVMDiskByAddress(spec.Resources.DiskList, adapter, index) != nil {
+				return fmt.Errorf("vm (%s) already has a disk at %s.%d", vmID, adapter, index)
+			}
+			disk.DeviceProperties.DiskAddress.DeviceIndex = utils.Int64(index)
+		}
+		spec.Resources.DiskList = append(spec.Resources.DiskList, disk)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error attaching disk to vm (%s): %s", vmID, err)
+	}
+
+	resp, err := conn.V3.GetVM(vmID)
+	if err != nil {
+		return err
+	}
+
+	var attached *v3.VMDisk
+	if disk.VolumeGroupReference != nil {
+		for _, dsk := range resp.Spec.Resources.DiskList {
+			if dsk.VolumeGroupReference != nil &&
+				utils.StringValue(dsk.VolumeGroupReference.UUID) == utils.StringValue(disk.VolumeGroupReference.UUID) {
+				attached = dsk
+			}
+		}
+	} else {
+		attached = findVMDiskByAddress(resp.Spec.Resources.DiskList, adapter,
+			utils.Int64Value(disk.DeviceProperties.DiskAddress.DeviceIndex))
+	}
+	if attached == nil || utils.StringValue(attached.UUID) == "" {
+		return fmt.Errorf("disk attached to vm (%s) was not found in its spec", vmID)
+	}
+
+	d.SetId(vmID + "/" + utils.StringValue(attached.UUID))
+
+	return resourceNutanixVMDiskRead(d, meta)
+}
+
+func resourceNutanixVMDiskRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	vmID, diskID := splitVMDeviceID(d.Id())
+
+	resp, err := conn.V3.GetVM(vmID)
+	if err != nil {
+		if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	disk := findVMDiskByUUID(resp.Spec.Resources.DiskList, diskID)
+	if disk == nil {
+		log.Printf("[WARN] Disk %s is no longer attached to vm (%s)", diskID, vmID)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("vm_id", vmID); err != nil {
+		return err
+	}
+	if err := d.Set("uuid", diskID); err != nil {
+		return err
+	}
+	if p := disk.DeviceProperties; p != nil && p.DiskAddress != nil {
+		if err := d.Set("adapter_type", utils.StringValue(p.DiskAddress.AdapterType)); err != nil {
+			return err
+		}
+		if err := d.Set("device_index", int(utils.Int64Value(p.DiskAddress.DeviceIndex))); err != nil {
+			return err
+		}
+	}
+	if disk.DiskSizeMib != nil {
+		if err := d.Set("disk_size_mib", int(utils.Int64Value(disk.DiskSizeMib))); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("disk_size_bytes", int(utils.Int64Value(disk.DiskSizeBytes))); err != nil {
+		return err
+	}
+	// The spec drops the data source of a disk once it is cloned, so keep the configured image then.
+	if disk.DataSourceReference != nil {
+		if err := d.Set("image_id", utils.StringValue(disk.DataSourceReference.UUID)); err != nil {
+			return err
+		}
+	}
+	if disk.VolumeGroupReference != nil {
+		if err := d.Set("volume_group_id", utils.StringValue(disk.VolumeGroupReference.UUID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceNutanixVMDiskUpdate grows the disk, which AHV applies while the VM is running.
+func resourceNutanixVMDiskUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	vmID, diskID := splitVMDeviceID(d.Id())
+
+	if d.HasChange("disk_size_mib") {
+		o, n := d.GetChange("disk_size_mib")
+		if n.(int) < o.(int) {
+			return fmt.Errorf("disk %s of vm (%s) can only grow, from %d MiB to %d MiB requested",
+				diskID, vmID, o.(int), n.(int))
+		}
+
+		vmMutexKV.Lock(vmID)
+		defer vmMutexKV.Unlock(vmID)
+
+		log.Printf("[DEBUG] Growing disk %s of vm (%s) to %d MiB", diskID, vmID, n.(int))
+
+		err := updateVMSpec(conn, vmID, func(spec *v3.VM) error {
+			disk := findVMDiskByUUID(spec.Resources.DiskList, diskID)
+			if disk == nil {
+				return fmt.Errorf("disk %s is no longer attached", diskID)
+			}
+			disk.DiskSizeMib = utils.Int64(int64(n.(int)))
+			disk.DiskSizeBytes = nil
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error growing disk %s of vm (%s): %s", diskID, vmID, err)
+		}
+	}
+
+	return resourceNutanixVMDiskRead(d, meta)
+}
+
+func resourceNutanixVMDiskDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	vmID, diskID := splitVMDeviceID(d.Id())
+
+	vmMutexKV.Lock(vmID)
+	defer vmMutexKV.Unlock(vmID)
+
+	log.Printf("[DEBUG] Detaching disk %s from vm (%s)", diskID, vmID)
+
+	err := updateVMSpec(conn, vmID, func(spec *v3.VM) error {
+		disks := spec.Resources.DiskList[:0]
+		for _, disk := range spec.Resources.DiskList {
+			if utils.StringValue(disk.UUID) != diskID {
+				disks = append(disks, disk)
+			}
+		}
+		spec.Resources.DiskList = disks
+		return nil
+	})
+	if err != nil && !strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+		return fmt.Errorf("Error detaching disk %s from vm (%s): %s", diskID, vmID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceNutanixVMDiskImport accepts <vm uuid>/<disk uuid> as well as <vm uuid>/<adapter>.<index>, e.g.
+// 3a4b.../SCSI.1, and stores the disk UUID in the ID.
+func resourceNutanixVMDiskImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*NutanixClient).API
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <vm uuid>/<disk uuid> or <vm uuid>/<adapter>.<index>", d.Id())
+	}
+	vmID, disk := parts[0], parts[1]
+
+	if !uuidRegexp.MatchString(disk) {
+		address := strings.SplitN(disk, ".", 2)
+		if len(address) != 2 {
+			return nil, fmt.Errorf("unexpected disk address %q, expected <adapter>.<index> such as SCSI.0", disk)
+		}
+		index, err := strconv.ParseInt(address[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected device index in disk address %q: %s", disk, err)
+		}
+
+		resp, err := conn.V3.GetVM(vmID)
+		if err != nil {
+			return nil, err
+		}
+		found := findVMDiskByAddress(resp.Spec.Resources.DiskList, strings.ToUpper(address[0]), index)
+		if found == nil {
+			return nil, fmt.Errorf("vm (%s) has no disk at %s", vmID, disk)
+		}
+		disk = utils.StringValue(found.UUID)
+	}
+
+	d.SetId(vmID + "/" + disk)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// splitVMDeviceID splits the <vm uuid>/<device uuid> ID of a device attached to a VM.
+func splitVMDeviceID(id string) (string, string) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return id, ""
+	}
+	return parts[0], parts[1]
+}
+
+func findVMDiskByUUID(disks []*v3.VMDisk, uuid string) *v3.VMDisk {
+	for _, disk := range disks {
+		if utils.StringValue(disk.UUID) == uuid {
+			return disk
+		}
+	}
+	return nil
+}
+
+func findVMDiskByAddress(disks []*v3.VMDisk, adapter string, index int64) *v3.VMDisk {
+	for _, disk := range disks {
+		if disk.DeviceProperties == nil || disk.DeviceProperties.DiskAddress == nil {
+			continue
+		}
+		address := disk.DeviceProperties.DiskAddress
+		if utils.StringValue(address.AdapterType) == adapter && utils.Int64Value(address.DeviceIndex) == index {
+			return disk
+		}
+	}
+	return nil
+}
+
+// nextDiskIndex returns the device index after the highest one in use on an adapter.
+func nextDiskIndex(disks []*v3.VMDisk, adapter string) int64 {
+	next := int64(0)
+	for _, disk := range disks {
+		if disk.DeviceProperties == nil || disk.DeviceProperties.DiskAddress == nil {
+			continue
+		}
+		address := disk.DeviceProperties.DiskAddress
+		if utils.StringValue(address.AdapterType) == adapter && utils.Int64Value(address.DeviceIndex) >= next {
+			next = utils.Int64Value(address.DeviceIndex) + 1
+		}
+	}
+	return next
+}
+
+func getVMDiskSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"vm_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateUUID,
+		},
+		"adapter_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "SCSI",
+			ValidateFunc: validateOneOf("SCSI", "IDE", "PCI", "SATA", "SPAPR"),
+		},
+		"device_index": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ForceNew:         true,
+			Default:          -1,
+			DiffSuppressFunc: suppressAutoDeviceIndexDiff,
+		},
+		"disk_size_mib": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"image_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ValidateFunc:  validateUUID,
+			ConflictsWith: []string{"volume_group_id"},
+		},
+		"volume_group_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ValidateFunc:  validateUUID,
+			ConflictsWith: []string{"image_id", "disk_size_mib", "device_index"},
+		},
+		"uuid": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"disk_size_bytes": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+// suppressAutoDeviceIndexDiff hides the difference between the default device_index of -1, which lets Create pick
+// the next free index, and the index the disk was given.
+func suppressAutoDeviceIndexDiff(k, old, new string, d *schema.ResourceData) bool {
+	return new == "-1" && old != ""
+}
@@ -0,0 +1,254 @@
+package nutanix
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNutanixVMDisk_basic(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixVMDiskConfig(r, 1024),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVMDiskExists("nutanix_vm_disk.data"),
+					resource.TestCheckResourceAttr("nutanix_vm_disk.data", "adapter_type", "SCSI"),
+					resource.TestCheckResourceAttr("nutanix_vm_disk.data", "disk_size_mib", "1024"),
+					resource.TestCheckResourceAttrSet("nutanix_vm_disk.data", "uuid"),
+				),
+			},
+			{
+				Config: testAccNutanixVMDiskConfig(r, 2048),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVMDiskExists("nutanix_vm_disk.data"),
+					resource.TestCheckResourceAttr("nutanix_vm_disk.data", "disk_size_mib", "2048"),
+				),
+			},
+			{
+				ResourceName:      "nutanix_vm_disk.data",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNutanixVMDisk_diskList(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixVMDiskConfigDiskList(r, 10240),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVMDiskExists("nutanix_vm_disk.data"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm1", "disk_list.#", "1"),
+				),
+			},
+			{
+				Config: testAccNutanixVMDiskConfigDiskList(r, 10240, 2048),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVMDiskExists("nutanix_vm_disk.data"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm1", "disk_list.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNutanixVMDisk_deviceIndexZero(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixVMDiskConfigDeviceIndexZero(r),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVMDiskExists("nutanix_vm_disk.data"),
+					resource.TestCheckResourceAttr("nutanix_vm_disk.data", "device_index", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNutanixVMDiskExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if !strings.Contains(rs.Primary.ID, "/") {
+			return fmt.Errorf("unexpected disk ID %q", rs.Primary.ID)
+		}
+
+		conn := testAccProvider.Meta().(*NutanixClient)
+
+		vmID, diskID := splitVMDeviceID(rs.Primary.ID)
+		resp, err := conn.API.V3.GetVM(vmID)
+		if err != nil {
+			return err
+		}
+		if findVMDiskByUUID(resp.Spec.Resources.DiskList, diskID) == nil {
+			return fmt.Errorf("disk %s is not attached to vm %s", diskID, vmID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNutanixVMDiskConfig(r, size int) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "vm1" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "ON"
+}
+
+resource "nutanix_vm_disk" "data" {
+  vm_id         = "${nutanix_virtual_machine.vm1.id}"
+  disk_size_mib = %d
+}
+`, r, size)
+}
+
+func testAccNutanixVMDiskConfigDiskList(r int, sizes ...int) string {
+	disks := ""
+	for i, size := range sizes {
+		disks += fmt.Sprintf(`
+    {
+      disk_size_mib = %d
+
+      device_properties = [
+        {
+          device_type = "DISK"
+
+          disk_address = [
+            {
+              adapter_type = "SCSI"
+              device_index = %d
+            },
+          ]
+        },
+      ]
+    },`, size, i)
+	}
+
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "vm1" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "ON"
+
+  disk_list = [%s
+  ]
+}
+
+resource "nutanix_vm_disk" "data" {
+  vm_id         = "${nutanix_virtual_machine.vm1.id}"
+  device_index  = 5
+  disk_size_mib = 1024
+}
+`, r, disks)
+}
+
+// testAccNutanixVMDiskConfigDeviceIndexZero leaves index 0 free below the disk of disk_list, so a disk attached
+// without device_index would get index 2.
+func testAccNutanixVMDiskConfigDeviceIndexZero(r int) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "vm1" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "ON"
+
+  disk_list = [
+    {
+      disk_size_mib = 10240
+
+      device_properties = [
+        {
+          device_type = "DISK"
+
+          disk_address = [
+            {
+              adapter_type = "SCSI"
+              device_index = 1
+            },
+          ]
+        },
+      ]
+    },
+  ]
+}
+
+resource "nutanix_vm_disk" "data" {
+  vm_id         = "${nutanix_virtual_machine.vm1.id}"
+  device_index  = 0
+  disk_size_mib = 1024
+}
+`, r)
+}
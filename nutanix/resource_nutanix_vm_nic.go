@@ -0,0 +1,429 @@
+package nutanix
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNutanixVMNic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNutanixVMNicCreate,
+		Read:   resourceNutanixVMNicRead,
+		Update: resourceNutanixVMNicUpdate,
+		Delete: resourceNutanixVMNicDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNutanixVMNicImport,
+		},
+		Schema: getVMNicSchema(),
+	}
+}
+
+func resourceNutanixVMNicCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	vmID := d.Get("vm_id").(string)
+	subnetID := d.Get("subnet_id").(string)
+
+	ips := expandVMNicIPs(d.Get("ip_endpoint_list").([]interface{}))
+	if len(ips) > 0 {
+		if err := validateSubnetStaticIPs(conn, subnetID, ips); err != nil {
+			return err
+		}
+	}
+
+	nic := &v3.VMNic{
+		SubnetReference: &v3.Reference{Kind: utils.String("subnet"), UUID: utils.String(subnetID)},
+		NicType:         utils.String(d.Get("nic_type").(string)),
+		IPEndpointList:  ips,
+	}
+	if v, ok := d.GetOk("model"); ok {
+		nic.Model = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("mac_address"); ok {
+		nic.MacAddress = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("network_function_nic_type"); ok {
+		nic.NetworkFunctionNicType = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("network_function_chain_id"); ok {
+		nic.NetworkFunctionChainReference = &v3.Reference{
+			Kind: utils.String("network_function_chain"),
+			UUID: utils.String(v.(string)),
+		}
+	}
+
+	vmMutexKV.Lock(vmID)
+	defer vmMutexKV.Unlock(vmID)
+
+	log.Printf("[DEBUG] Attaching nic on subnet %s to vm (%s)", subnetID, vmID)
+
+	existing := make(map[string]bool)
+	err := updateVMSpec(conn, vmID, func(spec *v3.VM) error {
+		for _, n := range spec.Resources.NicList {
+			existing[utils.StringValue(n.UUID)] = true
+		}
+		spec.Resources.NicList = append(spec.Resources.NicList, nic)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error attaching nic to vm (%s): %s", vmID, err)
+	}
+
+	resp, err := conn.V3.GetVM(vmID)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range resp.Spec.Resources.NicList {
+		if !existing[utils.StringValue(n.UUID)] && n.SubnetReference != nil &&
+			utils.StringValue(n.SubnetReference.UUID) == subnetID {
+			d.SetId(vmID + "/" + utils.StringValue(n.UUID))
+		}
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("nic attached to vm (%s) was not found in its spec", vmID)
+	}
+
+	return resourceNutanixVMNicRead(d, meta)
+}
+
+func resourceNutanixVMNicRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	vmID, nicID := splitVMDeviceID(d.Id())
+
+	resp, err := conn.V3.GetVM(vmID)
+	if err != nil {
+		if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	nic := findVMNicByUUID(resp.Spec.Resources.NicList, nicID)
+	if nic == nil {
+		log.Printf("[WARN] Nic %s is no longer attached to vm (%s)", nicID, vmID)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("vm_id", vmID); err != nil {
+		return err
+	}
+	if err := d.Set("uuid", nicID); err != nil {
+		return err
+	}
+	if nic.SubnetReference != nil {
+		if err := d.Set("subnet_id", utils.StringValue(nic.SubnetReference.UUID)); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("nic_type", utils.StringValue(nic.NicType)); err != nil {
+		return err
+	}
+	if err := d.Set("model", utils.StringValue(nic.Model)); err != nil {
+		return err
+	}
+	if err := d.Set("network_function_nic_type", utils.StringValue(nic.NetworkFunctionNicType)); err != nil {
+		return err
+	}
+	chain := ""
+	if nic.NetworkFunctionChainReference != nil {
+		chain = utils.StringValue(nic.NetworkFunctionChainReference.UUID)
+	}
+	if err := d.Set("network_function_chain_id", chain); err != nil {
+		return err
+	}
+
+	// Learned IPs are reported in ip_address_list only.
+	assigned := make([]map[string]interface{}, 0, len(nic.IPEndpointList))
+	for _, ip := range nic.IPEndpointList {
+		if utils.StringValue(ip.Type) == "LEARNED" {
+			continue
+		}
+		assigned = append(assigned, map[string]interface{}{
+			"ip":   utils.StringValue(ip.IP),
+			"type": utils.StringValue(ip.Type),
+		})
+	}
+	if err := d.Set("ip_endpoint_list", assigned); err != nil {
+		return err
+	}
+
+	// The MAC address and learned IPs are only known to the status.
+	mac := utils.StringValue(nic.MacAddress)
+	var ips []string
+	for _, n := range resp.Status.Resources.NicList {
+		if utils.StringValue(n.UUID) != nicID {
+			continue
+		}
+		if mac == "" {
+			mac = utils.StringValue(n.MacAddress)
+		}
+		for _, ip := range n.IPEndpointList {
+			ips = append(ips, utils.StringValue(ip.IP))
+		}
+	}
+	if err := d.Set("mac_address", mac); err != nil {
+		return err
+	}
+
+	return d.Set("ip_address_list", ips)
+}
+
+// resourceNutanixVMNicUpdate changes the static IPs of the NIC in place, keeping its MAC address.
+func resourceNutanixVMNicUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	vmID, nicID := splitVMDeviceID(d.Id())
+
+	if d.HasChange("ip_endpoint_list") {
+		ips := expandVMNicIPs(d.Get("ip_endpoint_list").([]interface{}))
+		if len(ips) > 0 {
+			if err := validateSubnetStaticIPs(conn, d.Get("subnet_id").(string), ips); err != nil {
+				return err
+			}
+		}
+
+		vmMutexKV.Lock(vmID)
+		defer vmMutexKV.Unlock(vmID)
+
+		log.Printf("[DEBUG] Updating static IPs of nic %s of vm (%s)", nicID, vmID)
+
+		err := updateVMSpec(conn, vmID, func(spec *v3.VM) error {
+			nic := findVMNicByUUID(spec.Resources.NicList, nicID)
+			if nic == nil {
+				return fmt.Errorf("nic %s is no longer attached", nicID)
+			}
+			nic.IPEndpointList = ips
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating nic %s of vm (%s): %s", nicID, vmID, err)
+		}
+	}
+
+	return resourceNutanixVMNicRead(d, meta)
+}
+
+func resourceNutanixVMNicDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	vmID, nicID := splitVMDeviceID(d.Id())
+
+	vmMutexKV.Lock(vmID)
+	defer vmMutexKV.Unlock(vmID)
+
+	log.Printf("[DEBUG] Detaching nic %s from vm (%s)", nicID, vmID)
+
+	err := updateVMSpec(conn, vmID, func(spec *v3.VM) error {
+		nics := spec.Resources.NicList[:0]
+		for _, nic := range spec.Resources.NicList {
+			if utils.StringValue(nic.UUID) != nicID {
+				nics = append(nics, nic)
+			}
+		}
+		spec.Resources.NicList = nics
+		return nil
+	})
+	if err != nil && !strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+		return fmt.Errorf("Error detaching nic %s from vm (%s): %s", nicID, vmID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceNutanixVMNicImport accepts <vm uuid>/<nic uuid> as well as <vm uuid>/<mac address>.
+func resourceNutanixVMNicImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*NutanixClient).API
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <vm uuid>/<nic uuid> or <vm uuid>/<mac address>", d.Id())
+	}
+	vmID, nic := parts[0], parts[1]
+
+	if !uuidRegexp.MatchString(nic) {
+		mac, err := net.ParseMAC(nic)
+		if err != nil {
+			return nil, fmt.Errorf("%q is neither a nic uuid nor a mac address", nic)
+		}
+
+		resp, err := conn.V3.GetVM(vmID)
+		if err != nil {
+			return nil, err
+		}
+		nic = ""
+		for _, n := range resp.Status.Resources.NicList {
+			if m, err := net.ParseMAC(utils.StringValue(n.MacAddress)); err == nil && bytes.Equal(m, mac) {
+				nic = utils.StringValue(n.UUID)
+			}
+		}
+		if nic == "" {
+			return nil, fmt.Errorf("vm (%s) has no nic with mac address %s", vmID, mac)
+		}
+	}
+
+	d.SetId(vmID + "/" + nic)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandVMNicIPs(list []interface{}) []*v3.IPAddress {
+	ips := make([]*v3.IPAddress, 0, len(list))
+	for _, v := range list {
+		ip := v.(map[string]interface{})
+		ips = append(ips, &v3.IPAddress{
+			IP:   utils.String(ip["ip"].(string)),
+			Type: utils.String("ASSIGNED"),
+		})
+	}
+	return ips
+}
+
+// validateSubnetStaticIPs checks that static IPs belong to a managed subnet, and to one of its pools when it
+// has any, before the NIC is sent to the API.
+func validateSubnetStaticIPs(conn *v3.Client, subnetID string, ips []*v3.IPAddress) error {
+	resp, err := conn.V3.GetSubnet(subnetID)
+	if err != nil {
+		return err
+	}
+
+	config := resp.Spec.Resources.IPConfig
+	if config == nil || utils.StringValue(config.SubnetIP) == "" {
+		return fmt.Errorf("subnet (%s) is not managed, static IPs can only be assigned on managed subnets", subnetID)
+	}
+
+	_, network, err := net.ParseCIDR(fmt.Sprintf("%s/%d", utils.StringValue(config.SubnetIP), utils.Int64Value(config.PrefixLength)))
+	if err != nil {
+		return fmt.Errorf("subnet (%s) has an invalid ip config: %s", subnetID, err)
+	}
+
+	for _, address := range ips {
+		ip := net.ParseIP(utils.StringValue(address.IP))
+		if ip == nil {
+			return fmt.Errorf("%q is not an IP address", utils.StringValue(address.IP))
+		}
+		if !network.Contains(ip) {
+			return fmt.Errorf("%s is not in subnet (%s) %s", ip, subnetID, network)
+		}
+		if len(config.PoolList) > 0 && !ipInPools(ip, config.PoolList) {
+			return fmt.Errorf("%s is not in any IP pool of subnet (%s)", ip, subnetID)
+		}
+	}
+
+	return nil
+}
+
+// ipInPools reports whether an IP is in one of the "<first> <last>" ranges of the pools.
+func ipInPools(ip net.IP, pools []*v3.IPPool) bool {
+	for _, pool := range pools {
+		bounds := strings.Fields(utils.StringValue(pool.Range))
+		if len(bounds) != 2 {
+			continue
+		}
+		first, last := net.ParseIP(bounds[0]).To16(), net.ParseIP(bounds[1]).To16()
+		if first == nil || last == nil {
+			continue
+		}
+		if bytes.Compare(ip.To16(), first) >= 0 && bytes.Compare(ip.To16(), last) <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func findVMNicByUUID(nics []*v3.VMNic, uuid string) *v3.VMNic {
+	for _, nic := range nics {
+		if utils.StringValue(nic.UUID) == uuid {
+			return nic
+		}
+	}
+	return nil
+}
+
+func getVMNicSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"vm_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateUUID,
+		},
+		"subnet_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateUUID,
+		},
+		"ip_endpoint_list": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ip": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"nic_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "NORMAL_NIC",
+			ValidateFunc: validateOneOf("NORMAL_NIC", "DIRECT_NIC", "NETWORK_FUNCTION_NIC"),
+		},
+		"model": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validateOneOf("VIRTIO", "E1000"),
+		},
+		"mac_address": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppressCaseDiff,
+		},
+		"network_function_nic_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validateOneOf("INGRESS", "EGRESS", "TAP"),
+		},
+		"network_function_chain_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validateUUID,
+		},
+		"uuid": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ip_address_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
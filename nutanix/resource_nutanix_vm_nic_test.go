@@ -0,0 +1,237 @@
+package nutanix
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNutanixVMNic_staticIP(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNutanixVMNicConfig(r, "192.168.1.10"),
+				ExpectError: regexp.MustCompile("is not in subnet"),
+			},
+			{
+				Config: testAccNutanixVMNicConfig(r, "10.5.80.50"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVMNicExists("nutanix_vm_nic.lan"),
+					resource.TestCheckResourceAttr("nutanix_vm_nic.lan", "ip_endpoint_list.0.ip", "10.5.80.50"),
+					resource.TestCheckResourceAttr("nutanix_vm_nic.lan", "ip_endpoint_list.0.type", "ASSIGNED"),
+					resource.TestCheckResourceAttrSet("nutanix_vm_nic.lan", "mac_address"),
+					resource.TestCheckResourceAttrSet("nutanix_vm_nic.lan", "uuid"),
+				),
+			},
+			{
+				Config: testAccNutanixVMNicConfig(r, "10.5.80.51"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVMNicExists("nutanix_vm_nic.lan"),
+					resource.TestCheckResourceAttr("nutanix_vm_nic.lan", "ip_endpoint_list.0.ip", "10.5.80.51"),
+				),
+			},
+			{
+				Config: testAccNutanixVMNicConfig(r, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVMNicExists("nutanix_vm_nic.lan"),
+					resource.TestCheckResourceAttr("nutanix_vm_nic.lan", "ip_endpoint_list.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "nutanix_vm_nic.lan",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNutanixVMNic_nicList(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixVMNicConfigNicList(r, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVMNicExists("nutanix_vm_nic.lan"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm1", "nic_list.#", "1"),
+				),
+			},
+			{
+				Config: testAccNutanixVMNicConfigNicList(r, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVMNicExists("nutanix_vm_nic.lan"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm1", "nic_list.#", "2"),
+				),
+			},
+			{
+				Config: testAccNutanixVMNicConfigNicList(r, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVMNicExists("nutanix_vm_nic.lan"),
+					resource.TestCheckResourceAttr("nutanix_virtual_machine.vm1", "nic_list.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNutanixVMNicExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*NutanixClient)
+
+		vmID, nicID := splitVMDeviceID(rs.Primary.ID)
+		resp, err := conn.API.V3.GetVM(vmID)
+		if err != nil {
+			return err
+		}
+		if findVMNicByUUID(resp.Spec.Resources.NicList, nicID) == nil {
+			return fmt.Errorf("nic %s is not attached to vm %s", nicID, vmID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNutanixVMNicConfig(r int, ip string) string {
+	ipEndpoint := ""
+	if ip != "" {
+		ipEndpoint = fmt.Sprintf(`
+  ip_endpoint_list {
+    ip = "%s"
+  }
+`, ip)
+	}
+
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_subnet" "lan" {
+  metadata = {
+    kind = "subnet"
+  }
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  name        = "test-dou-lan-%[1]d"
+  vlan_id     = 102
+  subnet_type = "VLAN"
+
+  prefix_length      = 20
+  default_gateway_ip = "10.5.80.1"
+  subnet_ip          = "10.5.80.0"
+}
+
+resource "nutanix_virtual_machine" "vm1" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%[1]d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "OFF"
+}
+
+resource "nutanix_vm_nic" "lan" {
+  vm_id     = "${nutanix_virtual_machine.vm1.id}"
+  subnet_id = "${nutanix_subnet.lan.id}"
+  model     = "VIRTIO"
+%[2]s}
+`, r, ipEndpoint)
+}
+
+// testAccNutanixVMNicConfigNicList gives the VM nics NICs in nic_list next to the one of nutanix_vm_nic.
+func testAccNutanixVMNicConfigNicList(r, nics int) string {
+	nicList := ""
+	for i := 0; i < nics; i++ {
+		nicList += `
+    {
+      subnet_reference = {
+        kind = "subnet"
+        uuid = "${nutanix_subnet.lan.id}"
+      }
+    },`
+	}
+
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_subnet" "lan" {
+  metadata = {
+    kind = "subnet"
+  }
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  name        = "test-dou-lan-%[1]d"
+  vlan_id     = 102
+  subnet_type = "VLAN"
+
+  prefix_length      = 20
+  default_gateway_ip = "10.5.80.1"
+  subnet_ip          = "10.5.80.0"
+}
+
+resource "nutanix_virtual_machine" "vm1" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%[1]d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket = 1
+  num_sockets          = 1
+  memory_size_mib      = 2048
+  power_state          = "OFF"
+
+  nic_list = [%[2]s
+  ]
+}
+
+resource "nutanix_vm_nic" "lan" {
+  vm_id     = "${nutanix_virtual_machine.vm1.id}"
+  subnet_id = "${nutanix_subnet.lan.id}"
+  model     = "VIRTIO"
+}
+`, r, nicList)
+}
@@ -0,0 +1,185 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// vmPowerAction is how a power action is carried out: the VM is powered off with the mechanism, and powered on
+// again for the actions that restart it.
+type vmPowerAction struct {
+	mechanism string
+	restart   bool
+}
+
+// vmPowerActions maps the actions of nutanix_vm_power_action to power state mechanisms. GUEST goes through
+// Nutanix Guest Tools and honours the guest transition config, ACPI sends a power button event and HARD
+// powers off without involving the guest.
+var vmPowerActions = map[string]vmPowerAction{
+	"reboot":         {mechanism: "GUEST", restart: true},
+	"acpi_shutdown":  {mechanism: "ACPI"},
+	"guest_shutdown": {mechanism: "GUEST"},
+	"reset":          {mechanism: "HARD", restart: true},
+	"power_cycle":    {mechanism: "ACPI", restart: true},
+}
+
+func resourceNutanixVMPowerAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNutanixVMPowerActionCreate,
+		Read:   resourceNutanixVMPowerActionRead,
+		Delete: resourceNutanixVMPowerActionDelete,
+		Schema: getVMPowerActionSchema(),
+	}
+}
+
+func resourceNutanixVMPowerActionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	uuid := d.Get("vm_id").(string)
+	name := d.Get("action").(string)
+	action := vmPowerActions[name]
+
+	vmMutexKV.Lock(uuid)
+	defer vmMutexKV.Unlock(uuid)
+
+	resp, err := conn.V3.GetVM(uuid)
+	if err != nil {
+		return err
+	}
+
+	mechanism := &v3.VMPowerStateMechanism{
+		Mechanism: utils.String(action.mechanism),
+	}
+	if action.mechanism == "GUEST" {
+		mechanism.GuestTransitionConfig = &v3.VMGuestPowerStateTransitionConfig{
+			EnableScriptExec:          utils.Bool(d.Get("enable_script_exec").(bool)),
+			ShouldFailOnScriptFailure: utils.Bool(d.Get("should_fail_on_script_failure").(bool)),
+		}
+	}
+
+	log.Printf("[DEBUG] Running power action %s on vm (%s)", name, uuid)
+
+	// A VM that is already off only needs powering on for the restarting actions. The mechanism of the action
+	// only applies to it, the VM gets its own back when it is powered on again or once it is off.
+	var previous *v3.VMPowerStateMechanism
+	if utils.StringValue(resp.Status.Resources.PowerState) == "ON" {
+		if previous, err = setVMPowerState(conn, uuid, "OFF", mechanism); err != nil {
+			return err
+		}
+	}
+	if !action.restart && previous != nil {
+		if err := restoreVMPowerStateMechanism(conn, uuid, previous); err != nil {
+			return err
+		}
+	}
+	if action.restart {
+		if _, err := setVMPowerState(conn, uuid, "ON", previous); err != nil {
+			return err
+		}
+
+		if d.Get("wait_for_guest_tools").(bool) {
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"UNREACHABLE"},
+				Target:     []string{"REACHABLE"},
+				Refresh:    vmGuestToolsRefreshFunc(conn, uuid),
+				Timeout:    10 * time.Minute,
+				Delay:      10 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			if _, err := stateConf.WaitForState(); err != nil {
+				return fmt.Errorf(
+					"Error waiting for guest tools of vm (%s) to be reachable: %s", uuid, err)
+			}
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return resourceNutanixVMPowerActionRead(d, meta)
+}
+
+func resourceNutanixVMPowerActionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	resp, err := conn.V3.GetVM(d.Get("vm_id").(string))
+	if err != nil {
+		if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return d.Set("power_state", utils.StringValue(resp.Status.Resources.PowerState))
+}
+
+// resourceNutanixVMPowerActionDelete only forgets the action, the VM is left in whatever state it is in.
+func resourceNutanixVMPowerActionDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func vmGuestToolsRefreshFunc(client *v3.Client, uuid string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := client.V3.GetVM(uuid)
+		if err != nil {
+			return nil, "", err
+		}
+
+		tools := v.Status.Resources.GuestTools
+		if tools != nil && tools.NutanixGuestTools != nil && utils.BoolValue(tools.NutanixGuestTools.IsReachable) {
+			return v, "REACHABLE", nil
+		}
+		return v, "UNREACHABLE", nil
+	}
+}
+
+func getVMPowerActionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"vm_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateUUID,
+		},
+		"action": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateOneOf("reboot", "acpi_shutdown", "guest_shutdown", "reset", "power_cycle"),
+		},
+		"triggers": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: true,
+		},
+		"enable_script_exec": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: true,
+		},
+		"should_fail_on_script_failure": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: true,
+		},
+		"wait_for_guest_tools": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: true,
+		},
+		"power_state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
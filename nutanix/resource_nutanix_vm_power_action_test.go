@@ -0,0 +1,119 @@
+package nutanix
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func TestAccNutanixVMPowerAction_basic(t *testing.T) {
+	r := acctest.RandInt()
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixVMPowerActionConfig(r, "power_cycle", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVirtualMachineID("nutanix_vm_power_action.test", &id),
+					resource.TestCheckResourceAttr("nutanix_vm_power_action.test", "power_state", "ON"),
+				),
+			},
+			{
+				Config: testAccNutanixVMPowerActionConfig(r, "power_cycle", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVirtualMachineRecreated("nutanix_vm_power_action.test", &id),
+					resource.TestCheckResourceAttr("nutanix_vm_power_action.test", "power_state", "ON"),
+				),
+			},
+			{
+				Config: testAccNutanixVMPowerActionConfig(r, "reset", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_vm_power_action.test", "power_state", "ON"),
+					testAccCheckNutanixVMPowerStateMechanism("nutanix_virtual_machine.vm1", "ACPI"),
+				),
+			},
+			{
+				Config: testAccNutanixVMPowerActionConfig(r, "acpi_shutdown", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_vm_power_action.test", "power_state", "OFF"),
+					testAccCheckNutanixVMPowerStateMechanism("nutanix_virtual_machine.vm1", "ACPI"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckNutanixVMPowerStateMechanism checks that a power action left the VM with its own power state
+// mechanism.
+func testAccCheckNutanixVMPowerStateMechanism(n, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*NutanixClient)
+
+		resp, err := conn.API.V3.GetVM(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		mechanism := ""
+		if resp.Spec.Resources.PowerStateMechanism != nil {
+			mechanism = utils.StringValue(resp.Spec.Resources.PowerStateMechanism.Mechanism)
+		}
+		if mechanism != expected {
+			return fmt.Errorf("power state mechanism of vm %s is %q, expected %q", rs.Primary.ID, mechanism, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccNutanixVMPowerActionConfig(r int, action, revision string) string {
+	return fmt.Sprintf(`
+variable clusterid {
+  default = "000567f3-1921-c722-471d-0cc47ac31055"
+}
+
+resource "nutanix_virtual_machine" "vm1" {
+  metadata {
+    kind = "vm"
+  }
+
+  name = "test-dou-%d"
+
+  cluster_reference = {
+    kind = "cluster"
+    uuid = "${var.clusterid}"
+  }
+
+  num_vcpus_per_socket  = 1
+  num_sockets           = 1
+  memory_size_mib       = 2048
+  power_state           = "ON"
+  power_state_mechanism = "ACPI"
+
+  lifecycle {
+    ignore_changes = ["power_state"]
+  }
+}
+
+resource "nutanix_vm_power_action" "test" {
+  vm_id  = "${nutanix_virtual_machine.vm1.id}"
+  action = "%s"
+
+  triggers {
+    revision = "%s"
+  }
+}
+`, r, action, revision)
+}
@@ -0,0 +1,577 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func resourceNutanixVolumeGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceNutanixVolumeGroupCreate,
+		Read:     resourceNutanixVolumeGroupRead,
+		Update:   resourceNutanixVolumeGroupUpdate,
+		Delete:   resourceNutanixVolumeGroupDelete,
+		Importer: importStateByName(unscopedLookup("volume_group", findVolumeGroupByName)),
+		Schema:   getVolumeGroupSchema(),
+	}
+}
+
+func resourceNutanixVolumeGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Creating Volume Group: %s", d.Get("name").(string))
+
+	conn := meta.(*NutanixClient).API
+
+	request := &v3.VolumeGroupInput{
+		Metadata: &v3.Metadata{
+			Kind: utils.String("volume_group"),
+		},
+		Spec: &v3.VolumeGroup{
+			Name:      utils.String(d.Get("name").(string)),
+			Resources: &v3.VolumeGroupResources{},
+		},
+	}
+
+	if v, ok := d.GetOk("api_version"); ok {
+		request.APIVersion = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("categories"); ok {
+		request.Metadata.Categories = expandCategoryAssignment(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("description"); ok {
+		request.Spec.Description = utils.String(v.(string))
+	}
+
+	getVolumeGroupResources(d, request.Spec.Resources)
+
+	utils.PrintToJSON(request, "CREATE METHOD REQUEST")
+
+	resp, err := conn.V3.CreateVolumeGroup(request)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(utils.StringValue(resp.Metadata.UUID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    volumeGroupStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for volume group (%s) to create: %s", d.Id(), err)
+	}
+
+	return resourceNutanixVolumeGroupRead(d, meta)
+}
+
+func resourceNutanixVolumeGroupRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Reading Volume Group: %s", d.Id())
+
+	conn := meta.(*NutanixClient).API
+
+	resp, err := conn.V3.GetVolumeGroup(d.Id())
+	if err != nil {
+		if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return setVolumeGroupAttributes(d, resp)
+}
+
+func resourceNutanixVolumeGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	log.Printf("[DEBUG] Updating Volume Group: %s", d.Id())
+
+	if err := checkVolumeGroupDiskChanges(d); err != nil {
+		return err
+	}
+
+	// The volume group is sent back as a whole, so start from what the cluster has to keep the VM attachments
+	// made through the virtual machine disk_list.
+	resp, err := conn.V3.GetVolumeGroup(d.Id())
+	if err != nil {
+		return err
+	}
+
+	request := &v3.VolumeGroupInput{
+		APIVersion: resp.APIVersion,
+		Metadata:   resp.Metadata,
+		Spec:       resp.Spec,
+	}
+	if request.Spec.Resources == nil {
+		request.Spec.Resources = &v3.VolumeGroupResources{}
+	}
+
+	if d.HasChange("categories") {
+		request.Metadata.Categories = expandCategoryAssignment(d.Get("categories").(map[string]interface{}))
+	}
+	if d.HasChange("name") {
+		request.Spec.Name = utils.String(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		request.Spec.Description = utils.String(d.Get("description").(string))
+	}
+
+	getVolumeGroupResources(d, request.Spec.Resources)
+
+	utils.PrintToJSON(request, "UPDATE METHOD REQUEST")
+
+	if _, err := conn.V3.UpdateVolumeGroup(d.Id(), request); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING"},
+		Target:     []string{"COMPLETE"},
+		Refresh:    volumeGroupStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for volume group (%s) to update: %s", d.Id(), err)
+	}
+
+	return resourceNutanixVolumeGroupRead(d, meta)
+}
+
+func resourceNutanixVolumeGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*NutanixClient).API
+
+	log.Printf("[DEBUG] Deleting Volume Group: %s", d.Id())
+
+	if err := conn.V3.DeleteVolumeGroup(d.Id()); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "RUNNING", "DELETE_IN_PROGRESS", "COMPLETE"},
+		Target:     []string{"DELETED"},
+		Refresh:    volumeGroupStateRefreshFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for volume group (%s) to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getVolumeGroupResources copies the configured resources into vg. VM attachments already present in vg are kept,
+// only the iSCSI initiator allowlist is replaced.
+func getVolumeGroupResources(d *schema.ResourceData, vg *v3.VolumeGroupResources) {
+	if v, ok := d.GetOk("flash_mode"); ok {
+		vg.FlashMode = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("file_system_type"); ok {
+		vg.FileSystemType = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("sharing_status"); ok {
+		vg.SharingStatus = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("iscsi_target_prefix"); ok {
+		vg.IscsiTargetPrefix = utils.String(v.(string))
+	}
+
+	if d.IsNewResource() || d.HasChange("disk_list") {
+		// Entries are matched to the disks of the volume group in index order, as Read lists them. An existing
+		// disk keeps its vmdisk and only its size is taken from the configuration, new disks get the next free
+		// index.
+		existing := sortVolumeGroupDisks(vg.DiskList)
+		next := int64(0)
+		for _, disk := range existing {
+			if index := utils.Int64Value(disk.Index); index >= next {
+				next = index + 1
+			}
+		}
+
+		disks := d.Get("disk_list").([]interface{})
+		vg.DiskList = make([]*v3.VGDisk, len(disks))
+		for k, val := range disks {
+			v := val.(map[string]interface{})
+			if k < len(existing) {
+				disk := existing[k]
+				if v1, ok := v["disk_size_mib"]; ok && v1.(int) != 0 {
+					disk.DiskSizeMib = utils.Int64(int64(v1.(int)))
+				}
+				vg.DiskList[k] = disk
+				continue
+			}
+
+			disk := &v3.VGDisk{
+				Index: utils.Int64(next),
+			}
+			next++
+			if v1, ok := v["disk_size_mib"]; ok && v1.(int) != 0 {
+				disk.DiskSizeMib = utils.Int64(int64(v1.(int)))
+			}
+			if v1, ok := v["storage_container_uuid"]; ok && v1.(string) != "" {
+				disk.StorageContainerUUID = utils.String(v1.(string))
+			}
+			if v1, ok := v["data_source_reference"]; ok && len(v1.(map[string]interface{})) > 0 {
+				dsr := v1.(map[string]interface{})
+				disk.DataSourceReference = &v3.Reference{
+					Kind: utils.String(dsr["kind"].(string)),
+					UUID: utils.String(dsr["uuid"].(string)),
+				}
+			}
+			vg.DiskList[k] = disk
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("iscsi_initiator_name_list") {
+		attachments := make([]*v3.VMAttachment, 0)
+		for _, a := range vg.AttachmentList {
+			if a.VMReference != nil {
+				attachments = append(attachments, a)
+			}
+		}
+		for _, name := range d.Get("iscsi_initiator_name_list").([]interface{}) {
+			attachments = append(attachments, &v3.VMAttachment{
+				IscsiInitiatorName: utils.String(name.(string)),
+			})
+		}
+		vg.AttachmentList = attachments
+	}
+}
+
+// sortVolumeGroupDisks returns the disks of a volume group in index order. The indices can have gaps, so disk_list
+// entries are matched to the disks by their position in this order rather than by index.
+func sortVolumeGroupDisks(disks []*v3.VGDisk) []*v3.VGDisk {
+	sorted := make([]*v3.VGDisk, len(disks))
+	copy(sorted, disks)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return utils.Int64Value(sorted[i].Index) < utils.Int64Value(sorted[j].Index)
+	})
+	return sorted
+}
+
+// checkVolumeGroupDiskChanges rejects disk_list changes that cannot be applied in place. The entries are matched
+// to the disks of the volume group by position, so disks can only be appended or grown.
+func checkVolumeGroupDiskChanges(d *schema.ResourceData) error {
+	if !d.HasChange("disk_list") {
+		return nil
+	}
+
+	o, n := d.GetChange("disk_list")
+	oldDisks := o.([]interface{})
+	newDisks := n.([]interface{})
+
+	if len(newDisks) < len(oldDisks) {
+		return fmt.Errorf("disks cannot be removed from volume group (%s): disk_list entries are matched to "+
+			"disks by position, only appending entries is supported", d.Id())
+	}
+
+	for k, val := range oldDisks {
+		oldDisk := val.(map[string]interface{})
+		newDisk := newDisks[k].(map[string]interface{})
+
+		if newDisk["disk_size_mib"].(int) < oldDisk["disk_size_mib"].(int) {
+			return fmt.Errorf("disk_list.%d: disk_size_mib of volume group (%s) disks can only grow", k, d.Id())
+		}
+
+		container := newDisk["storage_container_uuid"].(string)
+		if container != "" && container != oldDisk["storage_container_uuid"].(string) {
+			return fmt.Errorf("disk_list.%d: storage_container_uuid of an existing disk cannot change; disk_list "+
+				"entries are matched to disks by position, so reordering or replacing disks is not supported", k)
+		}
+
+		oldSource := oldDisk["data_source_reference"].(map[string]interface{})
+		newSource := newDisk["data_source_reference"].(map[string]interface{})
+		if uuid, ok := newSource["uuid"]; ok && uuid != "" && uuid != oldSource["uuid"] {
+			return fmt.Errorf("disk_list.%d: data_source_reference of an existing disk cannot change; disk_list "+
+				"entries are matched to disks by position, so reordering or replacing disks is not supported", k)
+		}
+	}
+
+	return nil
+}
+
+func setVolumeGroupAttributes(d *schema.ResourceData, resp *v3.VolumeGroupResponse) error {
+	if err := d.Set("metadata", flattenMetadata(resp.Metadata)); err != nil {
+		return err
+	}
+	if err := d.Set("categories", resp.Metadata.Categories); err != nil {
+		return err
+	}
+	if err := d.Set("api_version", utils.StringValue(resp.APIVersion)); err != nil {
+		return err
+	}
+
+	status := resp.Status
+	if status == nil {
+		status = &v3.VolumeGroupDefStatus{}
+	}
+	if err := d.Set("name", utils.StringValue(status.Name)); err != nil {
+		return err
+	}
+	if err := d.Set("description", utils.StringValue(status.Description)); err != nil {
+		return err
+	}
+	if err := d.Set("state", utils.StringValue(status.State)); err != nil {
+		return err
+	}
+
+	res := status.Resources
+	if res == nil {
+		res = &v3.VolumeGroupResourcesStatus{}
+	}
+	if err := d.Set("flash_mode", utils.StringValue(res.FlashMode)); err != nil {
+		return err
+	}
+	if err := d.Set("file_system_type", utils.StringValue(res.FileSystemType)); err != nil {
+		return err
+	}
+	if err := d.Set("sharing_status", utils.StringValue(res.SharingStatus)); err != nil {
+		return err
+	}
+	if err := d.Set("iscsi_target_prefix", utils.StringValue(res.IscsiTargetPrefix)); err != nil {
+		return err
+	}
+	if err := d.Set("iscsi_target_name", utils.StringValue(res.IscsiTargetName)); err != nil {
+		return err
+	}
+	if err := d.Set("size_mib", utils.Int64Value(res.SizeMib)); err != nil {
+		return err
+	}
+
+	// Keep disk_list in index order, its entries are matched to the disks by position.
+	diskList := sortVolumeGroupDisks(res.DiskList)
+
+	disks := make([]map[string]interface{}, len(diskList))
+	for k, v := range diskList {
+		disk := make(map[string]interface{})
+		disk["vmdisk_uuid"] = utils.StringValue(v.VmdiskUUID)
+		disk["index"] = utils.Int64Value(v.Index)
+		disk["disk_size_mib"] = utils.Int64Value(v.DiskSizeMib)
+		disk["storage_container_uuid"] = utils.StringValue(v.StorageContainerUUID)
+		dsr := make(map[string]interface{})
+		if v.DataSourceReference != nil {
+			dsr["kind"] = utils.StringValue(v.DataSourceReference.Kind)
+			dsr["uuid"] = utils.StringValue(v.DataSourceReference.UUID)
+		}
+		disk["data_source_reference"] = dsr
+		disks[k] = disk
+	}
+	if err := d.Set("disk_list", disks); err != nil {
+		return err
+	}
+
+	initiators := make([]string, 0)
+	vms := make([]map[string]interface{}, 0)
+	for _, a := range res.AttachmentList {
+		if a.VMReference != nil {
+			vms = append(vms, map[string]interface{}{
+				"kind": utils.StringValue(a.VMReference.Kind),
+				"uuid": utils.StringValue(a.VMReference.UUID),
+				"name": utils.StringValue(a.VMReference.Name),
+			})
+			continue
+		}
+		if a.IscsiInitiatorName != nil {
+			initiators = append(initiators, utils.StringValue(a.IscsiInitiatorName))
+		}
+	}
+	if err := d.Set("iscsi_initiator_name_list", initiators); err != nil {
+		return err
+	}
+
+	return d.Set("vm_attachment_list", vms)
+}
+
+func volumeGroupStateRefreshFunc(client *v3.Client, uuid string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := client.V3.GetVolumeGroup(uuid)
+
+		if err != nil {
+			if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+				return v, "DELETED", nil
+			}
+			log.Printf("ERROR %s", err)
+			return nil, "", err
+		}
+
+		if v.Status == nil {
+			return v, "PENDING", nil
+		}
+
+		return v, utils.StringValue(v.Status.State), nil
+	}
+}
+
+func getVolumeGroupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_version": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"metadata": getComputedMetadataSchema(),
+		"categories": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"flash_mode": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"file_system_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"sharing_status": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateOneOf("SHARED", "NOT_SHARED"),
+		},
+		"iscsi_target_prefix": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"iscsi_target_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"size_mib": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"disk_list": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"vmdisk_uuid": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"index": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"disk_size_mib": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Computed: true,
+					},
+					"storage_container_uuid": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"data_source_reference": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"kind": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"uuid": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"iscsi_initiator_name_list": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"vm_attachment_list": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"kind": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"uuid": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// findVolumeGroupByName returns the UUID of the only volume group with the given name.
+func findVolumeGroupByName(conn *v3.Client, name string) (string, error) {
+	log.Printf("[DEBUG] Looking up Volume Group: %s", name)
+
+	var uuids []string
+	err := utils.ListAll(100, func(offset, length int64) (int, int64, error) {
+		resp, err := conn.V3.ListVolumeGroup(listPage("volume_group", fiqlEquals("name", name), offset, length))
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, vg := range resp.Entities {
+			if vg.Status != nil && utils.StringValue(vg.Status.Name) == name {
+				uuids = append(uuids, utils.StringValue(vg.Metadata.UUID))
+			}
+		}
+		return len(resp.Entities), listTotal(resp.Metadata), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return singleMatch("volume_group", name, uuids)
+}
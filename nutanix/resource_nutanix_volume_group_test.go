@@ -0,0 +1,173 @@
+package nutanix
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func TestGetVolumeGroupResourcesIndexGaps(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getVolumeGroupSchema(), map[string]interface{}{
+		"disk_list": []interface{}{
+			map[string]interface{}{"disk_size_mib": 2048},
+			map[string]interface{}{"disk_size_mib": 4096},
+			map[string]interface{}{"disk_size_mib": 1024},
+		},
+	})
+
+	// The disk at index 1 was removed outside of Terraform, the API lists the others out of order.
+	vg := &v3.VolumeGroupResources{
+		DiskList: []*v3.VGDisk{
+			{Index: utils.Int64(2), VmdiskUUID: utils.String("disk-2"), DiskSizeMib: utils.Int64(2048)},
+			{Index: utils.Int64(0), VmdiskUUID: utils.String("disk-0"), DiskSizeMib: utils.Int64(1024)},
+		},
+	}
+	getVolumeGroupResources(d, vg)
+
+	expected := []struct {
+		index  int64
+		vmdisk string
+		size   int64
+	}{
+		{0, "disk-0", 2048},
+		{2, "disk-2", 4096},
+		{3, "", 1024},
+	}
+	if len(vg.DiskList) != len(expected) {
+		t.Fatalf("got %d disks, expected %d", len(vg.DiskList), len(expected))
+	}
+	for k, e := range expected {
+		disk := vg.DiskList[k]
+		if utils.Int64Value(disk.Index) != e.index || utils.StringValue(disk.VmdiskUUID) != e.vmdisk ||
+			utils.Int64Value(disk.DiskSizeMib) != e.size {
+			t.Errorf("disk_list.%d: got index %d, vmdisk %q, size %d, expected index %d, vmdisk %q, size %d", k,
+				utils.Int64Value(disk.Index), utils.StringValue(disk.VmdiskUUID), utils.Int64Value(disk.DiskSizeMib),
+				e.index, e.vmdisk, e.size)
+		}
+	}
+}
+
+func TestAccNutanixVolumeGroup_basic(t *testing.T) {
+	r := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNutanixVolumeGroupConfig(r),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNutanixVolumeGroupExists("nutanix_volume_group.test"),
+					resource.TestCheckResourceAttr("nutanix_volume_group.test", "sharing_status", "SHARED"),
+					resource.TestCheckResourceAttr("nutanix_volume_group.test", "disk_list.#", "2"),
+					resource.TestCheckResourceAttr("nutanix_volume_group.test", "iscsi_initiator_name_list.#", "1"),
+				),
+			},
+			{
+				Config: testAccNutanixVolumeGroupConfigDisks(r, 10240, 30720),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_volume_group.test", "disk_list.#", "2"),
+					resource.TestCheckResourceAttr("nutanix_volume_group.test", "disk_list.0.index", "0"),
+					resource.TestCheckResourceAttr("nutanix_volume_group.test", "disk_list.1.index", "1"),
+					resource.TestCheckResourceAttr("nutanix_volume_group.test", "disk_list.1.disk_size_mib", "30720"),
+				),
+			},
+			{
+				Config:      testAccNutanixVolumeGroupConfigDisks(r, 30720),
+				ExpectError: regexp.MustCompile("disks cannot be removed"),
+			},
+		},
+	})
+}
+
+func testAccCheckNutanixVolumeGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNutanixVolumeGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*NutanixClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nutanix_volume_group" {
+			continue
+		}
+		for {
+			_, err := conn.API.V3.GetVolumeGroup(rs.Primary.ID)
+			if err != nil {
+				if strings.Contains(fmt.Sprint(err), "ENTITY_NOT_FOUND") {
+					return nil
+				}
+				return err
+			}
+			time.Sleep(3000 * time.Millisecond)
+		}
+	}
+
+	return nil
+}
+
+func testAccNutanixVolumeGroupConfig(r int) string {
+	return fmt.Sprintf(`
+resource "nutanix_volume_group" "test" {
+  name           = "vg-db-%d"
+  description    = "Shared database volumes"
+  sharing_status = "SHARED"
+  flash_mode     = "ENABLED"
+
+  disk_list = [
+    {
+      disk_size_mib = 10240
+    },
+    {
+      disk_size_mib = 20480
+    },
+  ]
+
+  iscsi_initiator_name_list = ["iqn.1991-05.com.microsoft:db-%d"]
+}
+`, r, r)
+}
+
+func testAccNutanixVolumeGroupConfigDisks(r int, sizes ...int) string {
+	disks := ""
+	for _, size := range sizes {
+		disks += fmt.Sprintf(`
+    {
+      disk_size_mib = %d
+    },`, size)
+	}
+
+	return fmt.Sprintf(`
+resource "nutanix_volume_group" "test" {
+  name           = "vg-db-%d"
+  description    = "Shared database volumes"
+  sharing_status = "SHARED"
+  flash_mode     = "ENABLED"
+
+  disk_list = [%s
+  ]
+
+  iscsi_initiator_name_list = ["iqn.1991-05.com.microsoft:db-%d"]
+}
+`, r, disks, r)
+}
@@ -0,0 +1,302 @@
+package nutanix
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// flattenMetadata converts the generic entity metadata into the metadata map of the schema.
+func flattenMetadata(m *v3.Metadata) map[string]interface{} {
+	metadata := make(map[string]interface{})
+	metadata["last_update_time"] = utils.TimeValue(m.LastUpdateTime).String()
+	metadata["kind"] = utils.StringValue(m.Kind)
+	metadata["uuid"] = utils.StringValue(m.UUID)
+	metadata["creation_time"] = utils.TimeValue(m.CreationTime).String()
+	metadata["spec_version"] = strconv.Itoa(int(utils.Int64Value(m.SpecVersion)))
+	metadata["spec_hash"] = utils.StringValue(m.SpecHash)
+	metadata["name"] = utils.StringValue(m.Name)
+	return metadata
+}
+
+// singleMatch returns the only UUID found for the named entity, or an error listing every candidate.
+func singleMatch(kind, name string, uuids []string) (string, error) {
+	switch len(uuids) {
+	case 0:
+		return "", fmt.Errorf("no %s found with name %s", kind, name)
+	case 1:
+		return uuids[0], nil
+	}
+	return "", fmt.Errorf("found %d %ss with name %s: %s", len(uuids), kind, name, strings.Join(uuids, ", "))
+}
+
+// expandReference builds a reference from a kind/uuid/name map, defaulting the kind when it is not set.
+func expandReference(m map[string]interface{}, kind string) *v3.Reference {
+	r := &v3.Reference{
+		Kind: utils.String(kind),
+	}
+	if v, ok := m["kind"]; ok && v.(string) != "" {
+		r.Kind = utils.String(v.(string))
+	}
+	if v, ok := m["uuid"]; ok && v.(string) != "" {
+		r.UUID = utils.String(v.(string))
+	}
+	if v, ok := m["name"]; ok && v.(string) != "" {
+		r.Name = utils.String(v.(string))
+	}
+	return r
+}
+
+func expandReferenceList(list []interface{}, kind string) []*v3.Reference {
+	refs := make([]*v3.Reference, len(list))
+	for k, v := range list {
+		refs[k] = expandReference(v.(map[string]interface{}), kind)
+	}
+	return refs
+}
+
+func flattenReference(r *v3.Reference) map[string]interface{} {
+	return map[string]interface{}{
+		"kind": utils.StringValue(r.Kind),
+		"uuid": utils.StringValue(r.UUID),
+		"name": utils.StringValue(r.Name),
+	}
+}
+
+func flattenReferenceList(refs []*v3.Reference) []map[string]interface{} {
+	list := make([]map[string]interface{}, len(refs))
+	for k, r := range refs {
+		list[k] = flattenReference(r)
+	}
+	return list
+}
+
+// suppressCaseDiff hides a diff that only changes the case of the value, as for MAC addresses.
+func suppressCaseDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// suppressReferenceDiff hides the keys of a reference map that are filled in by the API, so a reference given
+// only by name or only by uuid does not show a diff. A computed key is only hidden while the configured key next
+// to it is unchanged, otherwise the stale value is dropped and the reference is resolved again.
+func suppressReferenceDiff(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		return true
+	}
+	if new != "" || old == "" {
+		return false
+	}
+
+	prefix := k[:strings.LastIndex(k, ".")+1]
+	switch strings.TrimPrefix(k, prefix) {
+	case "uuid":
+		return referenceKeyUnchanged(d, prefix+"name")
+	case "name":
+		return referenceKeyUnchanged(d, prefix+"uuid")
+	}
+	uuid, _ := d.Get(prefix + "uuid").(string)
+	name, _ := d.Get(prefix + "name").(string)
+	return uuid != "" || name != ""
+}
+
+func referenceKeyUnchanged(d *schema.ResourceData, k string) bool {
+	o, n := d.GetChange(k)
+	old, _ := o.(string)
+	new, _ := n.(string)
+	return new != "" && old == new
+}
+
+// validateOneOf returns a ValidateFunc accepting only the given string values.
+func validateOneOf(values ...string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, es []error) {
+		for _, value := range values {
+			if v.(string) == value {
+				return
+			}
+		}
+		es = append(es, fmt.Errorf("%q must be one of %s, got: %s", k, strings.Join(values, ", "), v.(string)))
+		return
+	}
+}
+
+func getReferenceListSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"kind": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"uuid": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func getComputedReferenceListSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"kind": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"uuid": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func getComputedMetadataSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"last_update_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"kind": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"uuid": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"creation_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"spec_version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"spec_hash": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// matchCategories reports whether an entity carries every category given in want.
+func matchCategories(have map[string]string, want map[string]interface{}) bool {
+	for k, v := range want {
+		if have[k] != v.(string) {
+			return false
+		}
+	}
+	return true
+}
+
+// validateRegexp checks that a filter argument is a valid regular expression.
+func validateRegexp(v interface{}, k string) (ws []string, es []error) {
+	if _, err := regexp.Compile(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%q is not a valid regular expression: %s", k, err))
+	}
+	return
+}
+
+func validateUUID(v interface{}, k string) (ws []string, es []error) {
+	if !uuidRegexp.MatchString(v.(string)) {
+		es = append(es, fmt.Errorf("%q must be a UUID, got %q", k, v.(string)))
+	}
+	return
+}
+
+// fiqlFilter joins the non-empty clauses of a FIQL filter with the AND operator.
+func fiqlFilter(clauses ...string) string {
+	var parts []string
+	for _, c := range clauses {
+		if c != "" {
+			parts = append(parts, c)
+		}
+	}
+	return strings.Join(parts, ";")
+}
+
+// fiqlEquals returns a FIQL clause matching attr against value. Values with characters that are reserved in FIQL
+// or taken as a regular expression by the API can't be passed through safely, those return an empty clause and
+// must be matched client side only.
+func fiqlEquals(attr, value string) string {
+	if value == "" || strings.ContainsAny(value, ";,()=!<>~'\"*+?[]{}|\\^$%") {
+		return ""
+	}
+	return fmt.Sprintf("%s==%s", attr, value)
+}
+
+// listPage returns the request for one page of a list call, the filter is left out when empty.
+func listPage(kind, filter string, offset, length int64) *v3.ListMetadata {
+	request := &v3.ListMetadata{
+		Kind:   utils.String(kind),
+		Length: utils.Int64(length),
+		Offset: utils.Int64(offset),
+	}
+	if filter != "" {
+		request.Filter = utils.String(filter)
+	}
+	return request
+}
+
+// listTotal returns the total number of matches reported by a list call.
+func listTotal(metadata *v3.ListMetadataOutput) int64 {
+	if metadata == nil {
+		return 0
+	}
+	return utils.Int64Value(metadata.TotalMatches)
+}
+
+// fiqlRegexClause returns a FIQL clause matching attr against a regular expression anywhere in the value.
+// Expressions using anchors or FIQL reserved characters can't be passed through safely, those return an
+// empty clause and must be matched client side only.
+func fiqlRegexClause(attr, expr string) string {
+	if expr == "" || strings.ContainsAny(expr, ";,()=!<>^$") {
+		return ""
+	}
+	return fmt.Sprintf("%s==.*%s.*", attr, expr)
+}
+
+// matchReference reports whether a reference points at want, given either as a UUID or as a name. An
+// empty want matches anything.
+func matchReference(ref *v3.Reference, want string) bool {
+	if want == "" {
+		return true
+	}
+	if ref == nil {
+		return false
+	}
+	return utils.StringValue(ref.UUID) == want || utils.StringValue(ref.Name) == want
+}
@@ -0,0 +1,381 @@
+package nutanix
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// bootDeviceTypes are the device types of boot_device_order_list. boot_order accepts them to order all devices
+// of a type.
+var bootDeviceTypes = []string{"CDROM", "DISK", "NETWORK"}
+
+var diskAdapterTypes = []string{"SCSI", "IDE", "PCI", "SATA", "SPAPR"}
+
+// bootReference is an entry of boot_order: a device type, a disk by position in disk_list or by address, or a
+// NIC by position in nic_list or by MAC address.
+type bootReference struct {
+	kind       string
+	deviceType string
+	position   int
+	adapter    string
+	index      int64
+	mac        string
+}
+
+func parseBootReference(s string) (*bootReference, error) {
+	for _, t := range bootDeviceTypes {
+		if s == t {
+			return &bootReference{deviceType: t, position: -1}, nil
+		}
+	}
+
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 || (parts[0] != "disk" && parts[0] != "nic") {
+		return nil, fmt.Errorf("%q is not one of %s or a disk: or nic: reference", s, strings.Join(bootDeviceTypes, ", "))
+	}
+	ref := &bootReference{kind: parts[0], position: -1}
+
+	if i, err := strconv.Atoi(parts[1]); err == nil && i >= 0 {
+		ref.position = i
+		return ref, nil
+	}
+
+	if ref.kind == "nic" {
+		mac, err := net.ParseMAC(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("%q does not reference a NIC by position or MAC address", s)
+		}
+		ref.mac = mac.String()
+		return ref, nil
+	}
+
+	address := strings.SplitN(parts[1], ".", 2)
+	if len(address) == 2 {
+		index, err := strconv.Atoi(address[1])
+		for _, adapter := range diskAdapterTypes {
+			if err == nil && index >= 0 && address[0] == adapter {
+				ref.adapter, ref.index = adapter, int64(index)
+				return ref, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%q does not reference a disk by position or as ADAPTER.index, e.g. disk:SCSI.0", s)
+}
+
+func (r *bootReference) String() string {
+	switch {
+	case r.kind == "":
+		return r.deviceType
+	case r.position >= 0:
+		return fmt.Sprintf("%s:%d", r.kind, r.position)
+	case r.kind == "nic":
+		return "nic:" + r.mac
+	}
+	return fmt.Sprintf("disk:%s.%d", r.adapter, r.index)
+}
+
+// validateBootReference checks the syntax of a boot_order entry, whether the device exists is checked against
+// disk_list and nic_list before the VM is changed.
+func validateBootReference(v interface{}, k string) (ws []string, es []error) {
+	if _, err := parseBootReference(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+// vmBootDevices are the devices boot_order is resolved against. Disks and NICs given by address or MAC address
+// are looked up on the whole VM, the ones given by position in disk_list and nic_list only among the devices
+// those manage, in configured order.
+type vmBootDevices struct {
+	disks    []*v3.VMDisk
+	macs     []string
+	diskList []*v3.VMDisk
+	nicList  []string
+}
+
+// newVMBootDevices collects the boot devices of a VM from its disks and from the UUIDs and MAC addresses of its
+// NICs.
+func newVMBootDevices(d *schema.ResourceData, disks []*v3.VMDisk, nicUUIDs, macs []string) *vmBootDevices {
+	devices := &vmBootDevices{disks: disks, macs: macs}
+
+	diskList := d.Get("disk_list").([]interface{})
+	if managed := vmDiskListUUIDs(d, diskList); managed != nil {
+		for _, v := range diskList {
+			if disk := findVMDiskByUUID(disks, v.(map[string]interface{})["uuid"].(string)); disk != nil {
+				devices.diskList = append(devices.diskList, disk)
+			}
+		}
+	} else {
+		cdroms := d.Get("cdrom").([]interface{})
+		for _, disk := range disks {
+			if !isCdromOf(disk, cdroms) {
+				devices.diskList = append(devices.diskList, disk)
+			}
+		}
+	}
+
+	nicList := d.Get("nic_list").([]interface{})
+	if managed := vmNicListUUIDs(d, nicList); managed != nil {
+		for _, v := range nicList {
+			uuid := v.(map[string]interface{})["uuid"].(string)
+			for i := range nicUUIDs {
+				if nicUUIDs[i] == uuid {
+					devices.nicList = append(devices.nicList, macs[i])
+				}
+			}
+		}
+	} else {
+		devices.nicList = macs
+	}
+
+	return devices
+}
+
+// resolveBootOrder turns boot_order into a boot config for the given devices. The first entry is the boot
+// device, and the types of all entries give the device order tried after it.
+func resolveBootOrder(order []interface{}, devices *vmBootDevices) (*v3.VMBootDevice, []*string, error) {
+	var device *v3.VMBootDevice
+	var types []*string
+	seen := make(map[string]bool)
+
+	for i, o := range order {
+		ref, err := parseBootReference(o.(string))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		deviceType := ref.deviceType
+		switch ref.kind {
+		case "disk":
+			disk, err := ref.findDisk(devices)
+			if err != nil {
+				return nil, nil, err
+			}
+			deviceType = "DISK"
+			if utils.StringValue(disk.DeviceProperties.DeviceType) == "CDROM" {
+				deviceType = "CDROM"
+			}
+			if i == 0 {
+				device = &v3.VMBootDevice{DiskAddress: disk.DeviceProperties.DiskAddress}
+			}
+		case "nic":
+			mac, err := ref.findNic(devices)
+			if err != nil {
+				return nil, nil, err
+			}
+			deviceType = "NETWORK"
+			if i == 0 {
+				device = &v3.VMBootDevice{MacAddress: utils.String(mac)}
+			}
+		}
+
+		if !seen[deviceType] {
+			seen[deviceType] = true
+			types = append(types, utils.String(deviceType))
+		}
+	}
+
+	return device, types, nil
+}
+
+func (r *bootReference) findDisk(devices *vmBootDevices) (*v3.VMDisk, error) {
+	var disk *v3.VMDisk
+	if r.position >= 0 {
+		if r.position < len(devices.diskList) {
+			disk = devices.diskList[r.position]
+		}
+	} else {
+		disk = findVMDiskByAddress(devices.disks, r.adapter, r.index)
+	}
+
+	if disk == nil {
+		return nil, fmt.Errorf("boot device %s is not attached to the vm", r)
+	}
+	if disk.DeviceProperties == nil || disk.DeviceProperties.DiskAddress == nil {
+		return nil, fmt.Errorf("boot device %s has no disk address yet", r)
+	}
+	return disk, nil
+}
+
+func (r *bootReference) findNic(devices *vmBootDevices) (string, error) {
+	var mac string
+	if r.position >= 0 {
+		if r.position < len(devices.nicList) {
+			mac = devices.nicList[r.position]
+		}
+	} else {
+		for _, m := range devices.macs {
+			if strings.EqualFold(m, r.mac) {
+				mac = m
+			}
+		}
+	}
+
+	if mac == "" {
+		return "", fmt.Errorf("boot device %s is not attached to the vm or has no MAC address yet", r)
+	}
+	return mac, nil
+}
+
+// checkVMBootConfig checks boot_type, machine_type and boot_order against each other and against the
+// configured disks and NICs, so an invalid boot config fails before the VM is changed.
+func checkVMBootConfig(d *schema.ResourceData) error {
+	bootType := d.Get("boot_type").(string)
+	machineType := d.Get("machine_type").(string)
+
+	if bootType == "SECURE_BOOT" && machineType != "Q35" {
+		return fmt.Errorf("boot_type SECURE_BOOT needs machine_type Q35")
+	}
+	if (bootType == "UEFI" || bootType == "SECURE_BOOT") && machineType == "PSERIES" {
+		return fmt.Errorf("boot_type %s is not supported with machine_type PSERIES", bootType)
+	}
+
+	disks := d.Get("disk_list").([]interface{})
+	nics := d.Get("nic_list").([]interface{})
+
+	for _, o := range d.Get("boot_order").([]interface{}) {
+		ref, err := parseBootReference(o.(string))
+		if err != nil {
+			return err
+		}
+
+		found := true
+		switch {
+		case ref.kind == "disk" && ref.position >= 0:
+			found = ref.position < len(disks)
+		case ref.kind == "disk":
+			found = hasConfiguredDiskAddress(d, ref.adapter, ref.index)
+		case ref.kind == "nic" && ref.position >= 0:
+			found = ref.position < len(nics)
+		case ref.kind == "nic":
+			found = false
+			for _, n := range nics {
+				if m := n.(map[string]interface{})["mac_address"].(string); strings.EqualFold(m, ref.mac) {
+					found = true
+				}
+			}
+		}
+		if !found {
+			return fmt.Errorf("boot_order: %s is not in disk_list, cdrom or nic_list", ref)
+		}
+	}
+
+	return nil
+}
+
+func hasConfiguredDiskAddress(d *schema.ResourceData, adapter string, index int64) bool {
+	for _, c := range d.Get("cdrom").([]interface{}) {
+		cdrom := c.(map[string]interface{})
+		if cdrom["adapter_type"].(string) == adapter && int64(cdrom["device_index"].(int)) == index {
+			return true
+		}
+	}
+
+	for _, dsk := range d.Get("disk_list").([]interface{}) {
+		for _, p := range dsk.(map[string]interface{})["device_properties"].([]interface{}) {
+			for _, a := range p.(map[string]interface{})["disk_address"].([]interface{}) {
+				address := a.(map[string]interface{})
+				if address["adapter_type"].(string) == adapter && int64(address["device_index"].(int)) == index {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// applyVMBootConfig sets boot_type, machine_type and the boot config of boot_order on a VM spec.
+func applyVMBootConfig(d *schema.ResourceData, spec *v3.VM) error {
+	res := spec.Resources
+	if res.BootConfig == nil {
+		res.BootConfig = &v3.VMBootConfig{}
+	}
+
+	if v, ok := d.GetOk("boot_type"); ok {
+		res.BootConfig.BootType = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("machine_type"); ok {
+		res.MachineType = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("boot_order"); ok {
+		uuids := make([]string, len(res.NicList))
+		macs := make([]string, len(res.NicList))
+		for i, nic := range res.NicList {
+			uuids[i] = utils.StringValue(nic.UUID)
+			macs[i] = utils.StringValue(nic.MacAddress)
+		}
+
+		device, types, err := resolveBootOrder(v.([]interface{}), newVMBootDevices(d, res.DiskList, uuids, macs))
+		if err != nil {
+			return err
+		}
+		res.BootConfig.BootDevice = device
+		res.BootConfig.BootDeviceOrderList = types
+	}
+
+	return nil
+}
+
+// flattenVMBootOrder returns boot_order as configured while it matches the boot config of the VM. When it does
+// not, the boot device and device order of the VM are returned so the difference shows in the plan.
+func flattenVMBootOrder(d *schema.ResourceData, boot *v3.VMBootConfig, disks []*v3.VMDisk, nics []*v3.VMNicOutputStatus) []string {
+	order := make([]string, 0)
+	configured := d.Get("boot_order").([]interface{})
+	if len(configured) == 0 || boot == nil {
+		return order
+	}
+
+	uuids := make([]string, len(nics))
+	macs := make([]string, len(nics))
+	for i, nic := range nics {
+		uuids[i] = utils.StringValue(nic.UUID)
+		macs[i] = utils.StringValue(nic.MacAddress)
+	}
+
+	device, types, err := resolveBootOrder(configured, newVMBootDevices(d, disks, uuids, macs))
+	if err == nil && sameBootDevice(device, boot.BootDevice) && len(types) <= len(boot.BootDeviceOrderList) {
+		matches := true
+		for i, t := range types {
+			matches = matches && utils.StringValue(t) == utils.StringValue(boot.BootDeviceOrderList[i])
+		}
+		if matches {
+			for _, o := range configured {
+				order = append(order, o.(string))
+			}
+			return order
+		}
+	}
+
+	if bd := boot.BootDevice; bd != nil && bd.DiskAddress != nil {
+		ref := &bootReference{kind: "disk", position: -1, adapter: utils.StringValue(bd.DiskAddress.AdapterType),
+			index: utils.Int64Value(bd.DiskAddress.DeviceIndex)}
+		order = append(order, ref.String())
+	} else if bd != nil && utils.StringValue(bd.MacAddress) != "" {
+		order = append(order, "nic:"+utils.StringValue(bd.MacAddress))
+	}
+	for _, t := range boot.BootDeviceOrderList {
+		order = append(order, utils.StringValue(t))
+	}
+
+	return order
+}
+
+func sameBootDevice(a, b *v3.VMBootDevice) bool {
+	if a == nil || b == nil {
+		return a == nil && (b == nil || (b.DiskAddress == nil && utils.StringValue(b.MacAddress) == ""))
+	}
+	if a.DiskAddress != nil {
+		return b.DiskAddress != nil &&
+			utils.StringValue(a.DiskAddress.AdapterType) == utils.StringValue(b.DiskAddress.AdapterType) &&
+			utils.Int64Value(a.DiskAddress.DeviceIndex) == utils.Int64Value(b.DiskAddress.DeviceIndex)
+	}
+	return strings.EqualFold(utils.StringValue(a.MacAddress), utils.StringValue(b.MacAddress))
+}
@@ -0,0 +1,243 @@
+package nutanix
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func TestParseBootReference(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected string
+		err      string
+	}{
+		{"DISK", "DISK", ""},
+		{"CDROM", "CDROM", ""},
+		{"NETWORK", "NETWORK", ""},
+		{"disk:0", "disk:0", ""},
+		{"nic:2", "nic:2", ""},
+		{"disk:SCSI.1", "disk:SCSI.1", ""},
+		{"disk:IDE.0", "disk:IDE.0", ""},
+		{"nic:50:6B:8D:AA:BB:CC", "nic:50:6b:8d:aa:bb:cc", ""},
+		{"disk", "", "is not one of"},
+		{"cdrom:0", "", "is not one of"},
+		{"disk:-1", "", "does not reference a disk"},
+		{"disk:NVME.0", "", "does not reference a disk"},
+		{"disk:SCSI.x", "", "does not reference a disk"},
+		{"nic:not-a-mac", "", "does not reference a NIC"},
+	}
+
+	for _, c := range cases {
+		ref, err := parseBootReference(c.value)
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("parseBootReference(%q) error = %v, expected one containing %q", c.value, err, c.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseBootReference(%q) unexpected error: %s", c.value, err)
+			continue
+		}
+		if got := ref.String(); got != c.expected {
+			t.Errorf("parseBootReference(%q) = %q, expected %q", c.value, got, c.expected)
+		}
+	}
+}
+
+func TestResolveBootOrder(t *testing.T) {
+	disks := []*v3.VMDisk{
+		testBootDisk("DISK", "SCSI", 0),
+		testBootDisk("CDROM", "IDE", 0),
+		{DeviceProperties: &v3.VMDiskDeviceProperties{DeviceType: utils.String("DISK")}},
+	}
+	macs := []string{"50:6b:8d:aa:bb:cc", "50:6b:8d:aa:bb:dd"}
+
+	cases := []struct {
+		name   string
+		order  []interface{}
+		device *v3.VMBootDevice
+		types  []string
+		err    string
+	}{
+		{
+			name:  "device types only",
+			order: []interface{}{"CDROM", "DISK"},
+			types: []string{"CDROM", "DISK"},
+		},
+		{
+			name:   "disk by position first",
+			order:  []interface{}{"disk:0", "NETWORK"},
+			device: &v3.VMBootDevice{DiskAddress: disks[0].DeviceProperties.DiskAddress},
+			types:  []string{"DISK", "NETWORK"},
+		},
+		{
+			name:   "cdrom by address counts as CDROM",
+			order:  []interface{}{"disk:IDE.0", "disk:SCSI.0"},
+			device: &v3.VMBootDevice{DiskAddress: disks[1].DeviceProperties.DiskAddress},
+			types:  []string{"CDROM", "DISK"},
+		},
+		{
+			name:   "nic by MAC ignores case",
+			order:  []interface{}{"nic:50:6B:8D:AA:BB:DD", "DISK"},
+			device: &v3.VMBootDevice{MacAddress: utils.String(macs[1])},
+			types:  []string{"NETWORK", "DISK"},
+		},
+		{
+			name:  "only the first entry is the boot device",
+			order: []interface{}{"DISK", "nic:0", "disk:0"},
+			types: []string{"DISK", "NETWORK"},
+		},
+		{
+			name:  "missing disk",
+			order: []interface{}{"disk:5"},
+			err:   "is not attached to the vm",
+		},
+		{
+			name:  "disk without address",
+			order: []interface{}{"disk:2"},
+			err:   "has no disk address yet",
+		},
+		{
+			name:  "missing nic",
+			order: []interface{}{"nic:2"},
+			err:   "is not attached to the vm or has no MAC address yet",
+		},
+	}
+
+	for _, c := range cases {
+		devices := &vmBootDevices{disks: disks, macs: macs, diskList: disks, nicList: macs}
+		device, types, err := resolveBootOrder(c.order, devices)
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%s: error = %v, expected one containing %q", c.name, err, c.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, err)
+			continue
+		}
+		if !sameBootDevice(c.device, device) || (c.device == nil) != (device == nil) {
+			t.Errorf("%s: boot device = %+v, expected %+v", c.name, device, c.device)
+		}
+		if got := strings.Join(utils.StringValueSlice(types), ","); got != strings.Join(c.types, ",") {
+			t.Errorf("%s: device order = %s, expected %s", c.name, got, strings.Join(c.types, ","))
+		}
+	}
+}
+
+func TestNewVMBootDevices(t *testing.T) {
+	vm := resourceNutanixVirtualMachine()
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"disk_list": vm.Schema["disk_list"],
+			"nic_list":  vm.Schema["nic_list"],
+			"cdrom":     vm.Schema["cdrom"],
+		},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"disk_list": []interface{}{
+			map[string]interface{}{"uuid": "disk-b"},
+			map[string]interface{}{"uuid": "disk-a"},
+		},
+		"nic_list": []interface{}{
+			map[string]interface{}{"uuid": "nic-b"},
+		},
+		"cdrom": []interface{}{
+			map[string]interface{}{"adapter_type": "IDE", "device_index": 0},
+		},
+	})
+
+	// The VM lists a CD-ROM of the cdrom block and the disk and NIC of nutanix_vm_disk and nutanix_vm_nic first.
+	cdrom := testBootDisk("CDROM", "IDE", 0)
+	attached := testBootDisk("DISK", "SCSI", 2)
+	attached.UUID = utils.String("disk-c")
+	diskA := testBootDisk("DISK", "SCSI", 0)
+	diskA.UUID = utils.String("disk-a")
+	diskB := testBootDisk("DISK", "SCSI", 1)
+	diskB.UUID = utils.String("disk-b")
+	disks := []*v3.VMDisk{cdrom, attached, diskA, diskB}
+	uuids := []string{"nic-c", "nic-b"}
+	macs := []string{"50:6b:8d:aa:bb:cc", "50:6b:8d:aa:bb:dd"}
+
+	cases := []struct {
+		order  string
+		device *v3.VMBootDevice
+		err    string
+	}{
+		{order: "disk:0", device: &v3.VMBootDevice{DiskAddress: diskB.DeviceProperties.DiskAddress}},
+		{order: "disk:1", device: &v3.VMBootDevice{DiskAddress: diskA.DeviceProperties.DiskAddress}},
+		{order: "disk:2", err: "is not attached to the vm"},
+		{order: "disk:SCSI.2", device: &v3.VMBootDevice{DiskAddress: attached.DeviceProperties.DiskAddress}},
+		{order: "nic:0", device: &v3.VMBootDevice{MacAddress: utils.String(macs[1])}},
+		{order: "nic:1", err: "is not attached to the vm"},
+		{order: "nic:50:6b:8d:aa:bb:cc", device: &v3.VMBootDevice{MacAddress: utils.String(macs[0])}},
+	}
+
+	devices := newVMBootDevices(d, disks, uuids, macs)
+	for _, c := range cases {
+		device, _, err := resolveBootOrder([]interface{}{c.order}, devices)
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%s: error = %v, expected one containing %q", c.order, err, c.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", c.order, err)
+			continue
+		}
+		if !sameBootDevice(c.device, device) {
+			t.Errorf("%s: boot device = %+v, expected %+v", c.order, device, c.device)
+		}
+	}
+}
+
+func TestSameBootDevice(t *testing.T) {
+	scsi0 := &v3.VMBootDevice{DiskAddress: testBootDisk("DISK", "SCSI", 0).DeviceProperties.DiskAddress}
+	scsi1 := &v3.VMBootDevice{DiskAddress: testBootDisk("DISK", "SCSI", 1).DeviceProperties.DiskAddress}
+	ide0 := &v3.VMBootDevice{DiskAddress: testBootDisk("CDROM", "IDE", 0).DeviceProperties.DiskAddress}
+	nic := &v3.VMBootDevice{MacAddress: utils.String("50:6b:8d:aa:bb:cc")}
+	nicUpper := &v3.VMBootDevice{MacAddress: utils.String("50:6B:8D:AA:BB:CC")}
+
+	cases := []struct {
+		name     string
+		a, b     *v3.VMBootDevice
+		expected bool
+	}{
+		{"both unset", nil, nil, true},
+		{"unset and empty", nil, &v3.VMBootDevice{}, true},
+		{"unset and disk", nil, scsi0, false},
+		{"disk and unset", scsi0, nil, false},
+		{"same disk", scsi0, &v3.VMBootDevice{DiskAddress: &v3.DiskAddress{
+			AdapterType: utils.String("SCSI"), DeviceIndex: utils.Int64(0)}}, true},
+		{"other index", scsi0, scsi1, false},
+		{"other adapter", scsi0, ide0, false},
+		{"disk and nic", scsi0, nic, false},
+		{"nic and disk", nic, scsi0, false},
+		{"MAC case", nic, nicUpper, true},
+	}
+
+	for _, c := range cases {
+		if got := sameBootDevice(c.a, c.b); got != c.expected {
+			t.Errorf("%s: sameBootDevice = %t, expected %t", c.name, got, c.expected)
+		}
+	}
+}
+
+func testBootDisk(deviceType, adapter string, index int64) *v3.VMDisk {
+	return &v3.VMDisk{
+		DeviceProperties: &v3.VMDiskDeviceProperties{
+			DeviceType: utils.String(deviceType),
+			DiskAddress: &v3.DiskAddress{
+				AdapterType: utils.String(adapter),
+				DeviceIndex: utils.Int64(index),
+			},
+		},
+	}
+}
@@ -0,0 +1,204 @@
+package nutanix
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// syncVMCdroms applies the cdrom blocks to a disk list. CD-ROMs of the old blocks that are no longer configured
+// are removed, configured ones are added or get their ISO inserted, swapped or ejected. Other disks are kept.
+func syncVMCdroms(conn *v3.Client, disks []*v3.VMDisk, old, cdroms []interface{}) ([]*v3.VMDisk, error) {
+	configured := make(map[string]bool)
+	for _, c := range cdroms {
+		cdrom := c.(map[string]interface{})
+		configured[cdromAddress(cdrom)] = true
+	}
+
+	for _, c := range old {
+		cdrom := c.(map[string]interface{})
+		if configured[cdromAddress(cdrom)] {
+			continue
+		}
+		kept := disks[:0]
+		for _, disk := range disks {
+			if !isCdromAt(disk, cdrom["adapter_type"].(string), int64(cdrom["device_index"].(int))) {
+				kept = append(kept, disk)
+			}
+		}
+		disks = kept
+	}
+
+	for _, c := range cdroms {
+		cdrom := c.(map[string]interface{})
+		adapter, index := cdrom["adapter_type"].(string), int64(cdrom["device_index"].(int))
+
+		image, err := resolveCdromImage(conn, cdrom)
+		if err != nil {
+			return nil, err
+		}
+
+		var disk *v3.VMDisk
+		for _, dsk := range disks {
+			if isCdromAt(dsk, adapter, index) {
+				disk = dsk
+			}
+		}
+		if disk == nil {
+			if findVMDiskByAddress(disks, adapter, index) != nil {
+				return nil, fmt.Errorf("a disk that is not a CD-ROM is attached at %s.%d", adapter, index)
+			}
+			disk = &v3.VMDisk{
+				DeviceProperties: &v3.VMDiskDeviceProperties{
+					DeviceType: utils.String("CDROM"),
+					DiskAddress: &v3.DiskAddress{
+						AdapterType: utils.String(adapter),
+						DeviceIndex: utils.Int64(index),
+					},
+				},
+			}
+			disks = append(disks, disk)
+		}
+
+		if image == "" {
+			disk.DataSourceReference = nil
+		} else {
+			disk.DataSourceReference = &v3.Reference{Kind: utils.String("image"), UUID: utils.String(image)}
+		}
+		// The size follows the inserted ISO.
+		disk.DiskSizeBytes = nil
+		disk.DiskSizeMib = nil
+	}
+
+	return disks, nil
+}
+
+// resolveCdromImage returns the UUID of the ISO to insert in a CD-ROM, or an empty string to leave it empty.
+func resolveCdromImage(conn *v3.Client, cdrom map[string]interface{}) (string, error) {
+	uuid := cdrom["image_id"].(string)
+	if name := cdrom["image_name"].(string); name != "" {
+		if uuid != "" {
+			return "", fmt.Errorf("only one of image_id and image_name can be set for the CD-ROM at %s", cdromAddress(cdrom))
+		}
+		var err error
+		if uuid, err = findImageByName(conn, name, "", nil); err != nil {
+			return "", err
+		}
+	}
+	if uuid == "" {
+		return "", nil
+	}
+
+	image, err := conn.V3.GetImage(uuid)
+	if err != nil {
+		return "", err
+	}
+	imageType := ""
+	if image.Status != nil {
+		imageType = utils.StringValue(image.Status.Resources.ImageType)
+	}
+	if imageType == "" && image.Spec != nil && image.Spec.Resources != nil {
+		imageType = utils.StringValue(image.Spec.Resources.ImageType)
+	}
+	if imageType != "ISO_IMAGE" {
+		return "", fmt.Errorf("image (%s) is a %s, only ISO_IMAGE images can be inserted in a CD-ROM", uuid, imageType)
+	}
+
+	return uuid, nil
+}
+
+// flattenVMCdroms returns the configured CD-ROMs as found in the disk list. The image is reported the way it
+// is configured, by name or by UUID, and CD-ROMs no cdrom block manages are left out.
+func flattenVMCdroms(d *schema.ResourceData, disks []*v3.VMDisk) []map[string]interface{} {
+	cdroms := make([]map[string]interface{}, 0)
+
+	for _, c := range d.Get("cdrom").([]interface{}) {
+		cdrom := c.(map[string]interface{})
+		adapter, index := cdrom["adapter_type"].(string), int64(cdrom["device_index"].(int))
+
+		for _, disk := range disks {
+			if !isCdromAt(disk, adapter, index) {
+				continue
+			}
+
+			m := map[string]interface{}{
+				"adapter_type": adapter,
+				"device_index": int(index),
+				"uuid":         utils.StringValue(disk.UUID),
+				"image_id":     "",
+				"image_name":   "",
+			}
+			if ref := disk.DataSourceReference; ref != nil {
+				if name := cdrom["image_name"].(string); name != "" {
+					m["image_name"] = name
+					if n := utils.StringValue(ref.Name); n != "" {
+						m["image_name"] = n
+					}
+				} else {
+					m["image_id"] = utils.StringValue(ref.UUID)
+				}
+			}
+			cdroms = append(cdroms, m)
+		}
+	}
+
+	return cdroms
+}
+
+// isCdromOf reports whether a disk is the CD-ROM of one of the cdrom blocks.
+func isCdromOf(disk *v3.VMDisk, cdroms []interface{}) bool {
+	for _, c := range cdroms {
+		cdrom := c.(map[string]interface{})
+		if isCdromAt(disk, cdrom["adapter_type"].(string), int64(cdrom["device_index"].(int))) {
+			return true
+		}
+	}
+	return false
+}
+
+func isCdromAt(disk *v3.VMDisk, adapter string, index int64) bool {
+	p := disk.DeviceProperties
+	return p != nil && utils.StringValue(p.DeviceType) == "CDROM" && p.DiskAddress != nil &&
+		utils.StringValue(p.DiskAddress.AdapterType) == adapter && utils.Int64Value(p.DiskAddress.DeviceIndex) == index
+}
+
+func cdromAddress(cdrom map[string]interface{}) string {
+	return fmt.Sprintf("%s.%d", cdrom["adapter_type"].(string), cdrom["device_index"].(int))
+}
+
+func getVMCdromSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"adapter_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "IDE",
+					ValidateFunc: validateOneOf("IDE", "SATA"),
+				},
+				"device_index": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  0,
+				},
+				"image_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateUUID,
+				},
+				"image_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"uuid": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
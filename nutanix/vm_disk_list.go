@@ -0,0 +1,80 @@
+package nutanix
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// vmDiskListUUIDs returns the UUIDs of the disks disk_list manages, or nil when it takes every disk of the VM,
+// as it does for a VM that was just created or imported. Disks attached later with nutanix_vm_disk are not in
+// it, so they are neither reported in disk_list nor detached when disk_list changes.
+func vmDiskListUUIDs(d *schema.ResourceData, diskList []interface{}) map[string]bool {
+	if d.IsNewResource() {
+		return nil
+	}
+
+	uuids := make(map[string]bool)
+	for _, v := range diskList {
+		if uuid := v.(map[string]interface{})["uuid"].(string); uuid != "" {
+			uuids[uuid] = true
+		}
+	}
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	return uuids
+}
+
+// flattenVMDiskList returns the disks disk_list manages, all of them when managed is nil. The CD-ROMs of the
+// cdrom blocks are always left out.
+func flattenVMDiskList(disks []*v3.VMDisk, managed map[string]bool, cdroms []interface{}) []map[string]interface{} {
+	diskList := make([]map[string]interface{}, 0, len(disks))
+
+	for _, v := range disks {
+		if (managed != nil && !managed[utils.StringValue(v.UUID)]) || isCdromOf(v, cdroms) {
+			continue
+		}
+
+		disk := make(map[string]interface{})
+		disk["uuid"] = utils.StringValue(v.UUID)
+		disk["disk_size_bytes"] = utils.Int64Value(v.DiskSizeBytes)
+		disk["disk_size_mib"] = utils.Int64Value(v.DiskSizeMib)
+
+		// Disks backed by a volume group carry no data source and no device properties.
+		if v.DataSourceReference != nil {
+			dsourceRef := make(map[string]interface{})
+			dsourceRef["kind"] = utils.StringValue(v.DataSourceReference.Kind)
+			dsourceRef["name"] = utils.StringValue(v.DataSourceReference.Name)
+			dsourceRef["uuid"] = utils.StringValue(v.DataSourceReference.UUID)
+			disk["data_source_reference"] = []map[string]interface{}{dsourceRef}
+		}
+
+		if v.VolumeGroupReference != nil {
+			volumeRef := make(map[string]interface{})
+			volumeRef["kind"] = utils.StringValue(v.VolumeGroupReference.Kind)
+			volumeRef["name"] = utils.StringValue(v.VolumeGroupReference.Name)
+			volumeRef["uuid"] = utils.StringValue(v.VolumeGroupReference.UUID)
+			disk["volume_group_reference"] = []map[string]interface{}{volumeRef}
+		}
+
+		if v.DeviceProperties != nil {
+			deviceProps := make(map[string]interface{})
+			deviceProps["device_type"] = utils.StringValue(v.DeviceProperties.DeviceType)
+
+			if v.DeviceProperties.DiskAddress != nil {
+				diskAddress := make(map[string]interface{})
+				diskAddress["device_index"] = utils.Int64Value(v.DeviceProperties.DiskAddress.DeviceIndex)
+				diskAddress["adapter_type"] = utils.StringValue(v.DeviceProperties.DiskAddress.AdapterType)
+				deviceProps["disk_address"] = []map[string]interface{}{diskAddress}
+			}
+
+			disk["device_properties"] = []map[string]interface{}{deviceProps}
+		}
+
+		diskList = append(diskList, disk)
+	}
+
+	return diskList
+}
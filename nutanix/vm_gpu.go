@@ -0,0 +1,201 @@
+package nutanix
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// resolveVMGpus fills in the device ID, vendor and mode of the gpu_list entries that name a GPU model or vGPU
+// profile, using the GPUs of the hosts in the VM's cluster. It then checks that one host has enough free GPUs
+// for all entries, since a VM runs on a single host.
+func resolveVMGpus(conn *v3.Client, d *schema.ResourceData, gpus []*v3.VMGpu) error {
+	if len(gpus) == 0 {
+		return nil
+	}
+
+	cluster := ""
+	if cr, ok := d.GetOk("cluster_reference"); ok {
+		cluster = cr.(map[string]interface{})["uuid"].(string)
+	}
+
+	hosts, err := listAllHosts(conn, "")
+	if err != nil {
+		return err
+	}
+	var inventory []*v3.HostResponse
+	for _, h := range hosts {
+		if h.Status != nil && h.Status.Resources != nil && matchReference(h.Status.ClusterReference, cluster) {
+			inventory = append(inventory, h)
+		}
+	}
+
+	entries := d.Get("gpu_list").([]interface{})
+	old, _ := d.GetChange("gpu_list")
+	for i, gpu := range gpus {
+		entry := entries[i].(map[string]interface{})
+		if !gpuResolvedByName(entry, old.([]interface{})) {
+			continue
+		}
+		name := entry["name"].(string)
+
+		ids := make(map[int64]*v3.HostGpu)
+		for _, h := range inventory {
+			for _, hg := range h.Status.Resources.GpuList {
+				if strings.EqualFold(utils.StringValue(hg.Name), name) && matchVMGpu(gpu, hg, false) {
+					ids[utils.Int64Value(hg.DeviceID)] = hg
+				}
+			}
+		}
+
+		switch len(ids) {
+		case 0:
+			return fmt.Errorf("gpu_list.%d: no %s GPU named %q on the hosts of cluster %s",
+				i, utils.StringValue(gpu.Vendor), name, cluster)
+		case 1:
+			for id, hg := range ids {
+				gpu.DeviceID = utils.Int64(id)
+				gpu.Vendor = hg.Vendor
+				if utils.StringValue(gpu.Mode) == "" {
+					gpu.Mode = hg.Mode
+				}
+			}
+		default:
+			var found []string
+			for id := range ids {
+				found = append(found, fmt.Sprint(id))
+			}
+			sort.Strings(found)
+			return fmt.Errorf("gpu_list.%d: GPUs named %q have device IDs %s, set vendor and mode to tell them apart",
+				i, name, strings.Join(found, ", "))
+		}
+		log.Printf("[DEBUG] Resolved GPU %q to device ID %d", name, utils.Int64Value(gpu.DeviceID))
+	}
+
+	for _, h := range inventory {
+		host := ""
+		if h.Metadata != nil {
+			host = utils.StringValue(h.Metadata.UUID)
+		}
+		if hostHasFreeGpus(h, gpus, d.Id(), vmHeldVgpus(old.([]interface{}), host)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("no host of cluster %s has free capacity for the GPUs in gpu_list", cluster)
+}
+
+// gpuResolvedByName tells whether a gpu_list entry is resolved by its name. The name and device ID are read back
+// from the VM, so an entry whose device_id differs from the one the VM had for the same name was given a new
+// device_id, which wins over the name. The entries of the old gpu_list are compared by name, not by position.
+func gpuResolvedByName(entry map[string]interface{}, old []interface{}) bool {
+	name := entry["name"].(string)
+	if name == "" {
+		return false
+	}
+	deviceID := entry["device_id"].(int)
+	if deviceID == 0 {
+		return true
+	}
+
+	named := false
+	for _, o := range old {
+		prev := o.(map[string]interface{})
+		if strings.EqualFold(prev["name"].(string), name) {
+			if prev["device_id"].(int) == deviceID {
+				return true
+			}
+			named = true
+		}
+	}
+	return !named
+}
+
+// matchVMGpu tells whether a host GPU fits a gpu_list entry, by device ID when the entry has one.
+func matchVMGpu(gpu *v3.VMGpu, hg *v3.HostGpu, byDeviceID bool) bool {
+	if v := utils.StringValue(gpu.Vendor); v != "" && v != utils.StringValue(hg.Vendor) {
+		return false
+	}
+	if m := utils.StringValue(gpu.Mode); m != "" && m != utils.StringValue(hg.Mode) {
+		return false
+	}
+	return !byDeviceID || utils.Int64Value(gpu.DeviceID) == utils.Int64Value(hg.DeviceID)
+}
+
+// hostHasFreeGpus tells whether a host has capacity for each entry. A GPU in passthrough mode takes one entry,
+// while a GPU in vGPU mode is shared by as many vGPUs of its profile as its fraction allows, so vGPUs are counted
+// per profile. GPUs and vGPUs the VM already holds count as free, so an update does not compete with the VM
+// itself; held gives the vGPUs the VM holds on this host by device ID.
+func hostHasFreeGpus(h *v3.HostResponse, gpus []*v3.VMGpu, vmUUID string, held map[int64]int64) bool {
+	used := make(map[*v3.HostGpu]bool)
+	vgpus := make(map[int64]int64)
+	for _, hg := range h.Status.Resources.GpuList {
+		if utils.StringValue(hg.Mode) == "VIRTUAL" {
+			vgpus[utils.Int64Value(hg.DeviceID)] += freeVgpus(hg)
+		}
+	}
+	for id, n := range held {
+		vgpus[id] += n
+	}
+
+	for _, gpu := range gpus {
+		found := false
+		for _, hg := range h.Status.Resources.GpuList {
+			if used[hg] || !matchVMGpu(gpu, hg, true) {
+				continue
+			}
+			if utils.StringValue(hg.Mode) == "VIRTUAL" {
+				if id := utils.Int64Value(hg.DeviceID); vgpus[id] > 0 {
+					vgpus[id]--
+					found = true
+					break
+				}
+				continue
+			}
+			consumer := hg.ConsumerReference
+			held := consumer != nil && vmUUID != "" && utils.StringValue(consumer.UUID) == vmUUID
+			if utils.BoolValue(hg.Assignable) || held {
+				used[hg] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// freeVgpus returns how many more vGPUs of its profile a GPU in vGPU mode takes. A vGPU uses 1/fraction of the
+// GPU, so the GPU holds fraction vGPUs.
+func freeVgpus(hg *v3.HostGpu) int64 {
+	if !utils.BoolValue(hg.Assignable) {
+		return 0
+	}
+	capacity := utils.Int64Value(hg.Fraction)
+	if capacity < 1 {
+		capacity = 1
+	}
+	if free := capacity - utils.Int64Value(hg.NumVgpusAllocated); free > 0 {
+		return free
+	}
+	return 0
+}
+
+// vmHeldVgpus counts the vGPUs of a gpu_list that the VM holds on a host, by device ID.
+func vmHeldVgpus(gpuList []interface{}, host string) map[int64]int64 {
+	held := make(map[int64]int64)
+	for _, g := range gpuList {
+		gpu := g.(map[string]interface{})
+		if host != "" && gpu["host_uuid"].(string) == host && gpu["mode"].(string) == "VIRTUAL" {
+			held[int64(gpu["device_id"].(int))]++
+		}
+	}
+	return held
+}
@@ -0,0 +1,103 @@
+package nutanix
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+func TestGpuResolvedByName(t *testing.T) {
+	gpu := func(name string, deviceID int) map[string]interface{} {
+		return map[string]interface{}{"name": name, "device_id": deviceID}
+	}
+	old := []interface{}{gpu("Tesla M60", 13), gpu("GRID M60-2Q", 11)}
+
+	cases := []struct {
+		name     string
+		entry    map[string]interface{}
+		old      []interface{}
+		expected bool
+	}{
+		{"no name", gpu("", 13), old, false},
+		{"new VM", gpu("Tesla M60", 0), nil, true},
+		{"name and device ID of a new VM", gpu("Tesla M60", 13), nil, true},
+		{"name added", gpu("GRID M60-2Q", 11), old[:1], true},
+		{"unchanged", gpu("Tesla M60", 13), old, true},
+		{"moved to another position", gpu("GRID M60-2Q", 11), []interface{}{old[1], old[0]}, true},
+		{"name case", gpu("tesla m60", 13), old, true},
+		{"changed device ID", gpu("Tesla M60", 11), old, false},
+		{"changed name keeps the device ID of the position", gpu("GRID M60-4Q", 13), old, true},
+	}
+
+	for _, c := range cases {
+		if got := gpuResolvedByName(c.entry, c.old); got != c.expected {
+			t.Errorf("%s: gpuResolvedByName = %t, expected %t", c.name, got, c.expected)
+		}
+	}
+}
+
+func TestHostHasFreeGpus(t *testing.T) {
+	passthrough := func(consumer string) *v3.HostGpu {
+		hg := &v3.HostGpu{
+			Vendor:     utils.String("NVIDIA"),
+			Mode:       utils.String("PASSTHROUGH_GRAPHICS"),
+			DeviceID:   utils.Int64(13),
+			Assignable: utils.Bool(consumer == ""),
+		}
+		if consumer != "" {
+			hg.ConsumerReference = &v3.Reference{Kind: utils.String("vm"), UUID: utils.String(consumer)}
+		}
+		return hg
+	}
+	vgpu := func(fraction, allocated int64) *v3.HostGpu {
+		return &v3.HostGpu{
+			Vendor:            utils.String("NVIDIA"),
+			Mode:              utils.String("VIRTUAL"),
+			DeviceID:          utils.Int64(11),
+			Fraction:          utils.Int64(fraction),
+			NumVgpusAllocated: utils.Int64(allocated),
+			Assignable:        utils.Bool(allocated < fraction),
+		}
+	}
+	want := func(mode string, deviceID int64, n int) []*v3.VMGpu {
+		gpus := make([]*v3.VMGpu, n)
+		for i := range gpus {
+			gpus[i] = &v3.VMGpu{Mode: utils.String(mode), DeviceID: utils.Int64(deviceID)}
+		}
+		return gpus
+	}
+
+	cases := []struct {
+		name     string
+		host     []*v3.HostGpu
+		gpus     []*v3.VMGpu
+		held     map[int64]int64
+		expected bool
+	}{
+		{"free passthrough GPU", []*v3.HostGpu{passthrough("")}, want("PASSTHROUGH_GRAPHICS", 13, 1), nil, true},
+		{"one passthrough GPU for two entries", []*v3.HostGpu{passthrough("")}, want("PASSTHROUGH_GRAPHICS", 13, 2),
+			nil, false},
+		{"passthrough GPU of another VM", []*v3.HostGpu{passthrough("vm-2")}, want("PASSTHROUGH_GRAPHICS", 13, 1),
+			nil, false},
+		{"passthrough GPU the VM holds", []*v3.HostGpu{passthrough("vm-1")}, want("PASSTHROUGH_GRAPHICS", 13, 1),
+			nil, true},
+		{"other mode", []*v3.HostGpu{passthrough("")}, want("PASSTHROUGH_COMPUTE", 13, 1), nil, false},
+		{"vGPUs of one GPU", []*v3.HostGpu{vgpu(4, 1)}, want("VIRTUAL", 11, 3), nil, true},
+		{"more vGPUs than one GPU holds", []*v3.HostGpu{vgpu(4, 1)}, want("VIRTUAL", 11, 4), nil, false},
+		{"vGPUs of two GPUs", []*v3.HostGpu{vgpu(2, 1), vgpu(2, 0)}, want("VIRTUAL", 11, 3), nil, true},
+		{"full GPU", []*v3.HostGpu{vgpu(2, 2)}, want("VIRTUAL", 11, 1), nil, false},
+		{"vGPUs the VM holds", []*v3.HostGpu{vgpu(2, 2)}, want("VIRTUAL", 11, 2), map[int64]int64{11: 2}, true},
+		{"one more vGPU than the VM holds", []*v3.HostGpu{vgpu(2, 2)}, want("VIRTUAL", 11, 3), map[int64]int64{11: 2},
+			false},
+		{"passthrough and vGPU", []*v3.HostGpu{passthrough(""), vgpu(8, 0)},
+			append(want("PASSTHROUGH_GRAPHICS", 13, 1), want("VIRTUAL", 11, 8)...), nil, true},
+	}
+
+	for _, c := range cases {
+		h := &v3.HostResponse{Status: &v3.HostStatus{Resources: &v3.HostResources{GpuList: c.host}}}
+		if got := hostHasFreeGpus(h, c.gpus, "vm-1", c.held); got != c.expected {
+			t.Errorf("%s: hostHasFreeGpus = %t, expected %t", c.name, got, c.expected)
+		}
+	}
+}
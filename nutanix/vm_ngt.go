@@ -0,0 +1,172 @@
+package nutanix
+
+import (
+	"fmt"
+	"time"
+
+	version "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// expandVMNgt returns the Nutanix Guest Tools spec of the ngt block.
+func expandVMNgt(d *schema.ResourceData) *v3.NutanixGuestToolsSpec {
+	state, mount := "DISABLED", "UNMOUNTED"
+	if d.Get("ngt.0.enabled").(bool) {
+		state = "ENABLED"
+	}
+	if d.Get("ngt.0.iso_mounted").(bool) {
+		mount = "MOUNTED"
+	}
+
+	capabilities := make([]*string, 0)
+	for _, c := range d.Get("ngt.0.capabilities").(*schema.Set).List() {
+		capabilities = append(capabilities, utils.String(c.(string)))
+	}
+
+	return &v3.NutanixGuestToolsSpec{
+		State:                 utils.String(state),
+		IsoMountState:         utils.String(mount),
+		EnabledCapabilityList: capabilities,
+	}
+}
+
+// flattenVMNgt returns the ngt block for the guest tools status of a VM, or no block when ngt is not configured,
+// so that it only takes effect for VMs that set it. Removing the block leaves NGT of the VM as it is.
+// wait_for_communication only exists in the configuration and is kept from it.
+func flattenVMNgt(d *schema.ResourceData, tools *v3.GuestToolsStatus) []map[string]interface{} {
+	if _, ok := d.GetOk("ngt"); !ok {
+		return nil
+	}
+	wait := d.Get("ngt.0.wait_for_communication").(bool)
+
+	ngt := &v3.NutanixGuestToolsStatus{}
+	if tools != nil && tools.NutanixGuestTools != nil {
+		ngt = tools.NutanixGuestTools
+	}
+
+	capabilities := schema.NewSet(schema.HashString, nil)
+	for _, v := range ngt.EnabledCapabilityList {
+		capabilities.Add(utils.StringValue(v))
+	}
+
+	installed := utils.StringValue(ngt.Version)
+	available := utils.StringValue(ngt.AvailableVersion)
+
+	return []map[string]interface{}{{
+		"enabled":                utils.StringValue(ngt.State) == "ENABLED",
+		"iso_mounted":            utils.StringValue(ngt.IsoMountState) == "MOUNTED",
+		"capabilities":           capabilities,
+		"wait_for_communication": wait,
+		"communication_active":   utils.BoolValue(ngt.IsReachable),
+		"installed_version":      installed,
+		"available_version":      available,
+		"upgrade_available":      ngtUpgradeAvailable(installed, available),
+		"vss_snapshot_capable":   utils.BoolValue(ngt.VSSSnapshotCapable),
+	}}
+}
+
+// ngtUpgradeAvailable tells whether the cluster has a newer NGT version than the one installed in the guest.
+// Versions that do not parse are only compared for equality.
+func ngtUpgradeAvailable(installed, available string) bool {
+	if installed == "" || available == "" {
+		return false
+	}
+
+	i, err := version.NewVersion(installed)
+	if err != nil {
+		return installed != available
+	}
+	a, err := version.NewVersion(available)
+	if err != nil {
+		return installed != available
+	}
+	return a.GreaterThan(i)
+}
+
+// ngtWaitForCommunication tells whether to wait for NGT communication after the ngt block is applied: NGT has
+// to be enabled, the VM running and wait_for_communication left on.
+func ngtWaitForCommunication(d *schema.ResourceData) bool {
+	if _, ok := d.GetOk("ngt"); !ok {
+		return false
+	}
+	return d.Get("ngt.0.enabled").(bool) && d.Get("ngt.0.wait_for_communication").(bool) &&
+		d.Get("power_state").(string) != "OFF"
+}
+
+func waitForVMNgtCommunication(conn *v3.Client, uuid string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"UNREACHABLE"},
+		Target:     []string{"REACHABLE"},
+		Refresh:    vmGuestToolsRefreshFunc(conn, uuid),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for guest tools of vm (%s) to be reachable: %s", uuid, err)
+	}
+	return nil
+}
+
+func getVMNgtSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"nutanix_guest_tools"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"iso_mounted": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				// The API lists the capabilities in an order of its own.
+				"capabilities": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Set:      schema.HashString,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateOneOf("SELF_SERVICE_RESTORE", "VSS_SNAPSHOT"),
+					},
+				},
+				"wait_for_communication": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"communication_active": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"installed_version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"available_version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"upgrade_available": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"vss_snapshot_capable": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package nutanix
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-nutanix/client/v3"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// vmNicListUUIDs returns the UUIDs of the NICs nic_list manages, or nil when it takes every NIC of the VM, as it
+// does for a VM that was just created or imported. NICs attached later with nutanix_vm_nic are not in it, so they
+// are neither reported in nic_list nor detached when nic_list changes.
+func vmNicListUUIDs(d *schema.ResourceData, nicList []interface{}) map[string]bool {
+	if d.IsNewResource() {
+		return nil
+	}
+
+	uuids := make(map[string]bool)
+	for _, v := range nicList {
+		if uuid := v.(map[string]interface{})["uuid"].(string); uuid != "" {
+			uuids[uuid] = true
+		}
+	}
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	return uuids
+}
+
+// flattenVMNicList returns the NICs nic_list manages, all of them when managed is nil.
+func flattenVMNicList(nics []*v3.VMNicOutputStatus, managed map[string]bool) []map[string]interface{} {
+	nicList := make([]map[string]interface{}, 0, len(nics))
+
+	for _, v := range nics {
+		if managed != nil && !managed[utils.StringValue(v.UUID)] {
+			continue
+		}
+
+		nic := make(map[string]interface{})
+		// simple first
+		nic["nic_type"] = utils.StringValue(v.NicType)
+		nic["uuid"] = utils.StringValue(v.UUID)
+		nic["floating_ip"] = utils.StringValue(v.FloatingIP)
+		nic["network_function_nic_type"] = utils.StringValue(v.NetworkFunctionNicType)
+		nic["mac_address"] = utils.StringValue(v.MacAddress)
+		nic["model"] = utils.StringValue(v.Model)
+
+		// set ip lists value
+		ipEndpointList := make([]map[string]interface{}, len(v.IPEndpointList))
+		for k1, v1 := range v.IPEndpointList {
+			ipEndpoint := make(map[string]interface{})
+			ipEndpoint["ip"] = utils.StringValue(v1.IP)
+			ipEndpoint["type"] = utils.StringValue(v1.Type)
+			ipEndpointList[k1] = ipEndpoint
+		}
+		nic["ip_endpoint_list"] = ipEndpointList
+
+		// set network_function_chain_reference value
+		netFnChainRef := make(map[string]interface{})
+		if v.NetworkFunctionChainReference != nil {
+			netFnChainRef["kind"] = utils.StringValue(v.NetworkFunctionChainReference.Kind)
+			netFnChainRef["name"] = utils.StringValue(v.NetworkFunctionChainReference.Name)
+			netFnChainRef["uuid"] = utils.StringValue(v.NetworkFunctionChainReference.UUID)
+		}
+		nic["network_function_chain_reference"] = netFnChainRef
+
+		// set subnet_reference value
+		subtnetRef := make(map[string]interface{})
+		if v.SubnetReference != nil {
+			subtnetRef["kind"] = utils.StringValue(v.SubnetReference.Kind)
+			subtnetRef["name"] = utils.StringValue(v.SubnetReference.Name)
+			subtnetRef["uuid"] = utils.StringValue(v.SubnetReference.UUID)
+		}
+		nic["subnet_reference"] = subtnetRef
+
+		nicList = append(nicList, nic)
+	}
+
+	return nicList
+}
@@ -12,3 +12,21 @@ func PrintToJSON(v interface{}, msg string) {
 	log.Print("\n", msg, string(pretty))
 	fmt.Print("\n", msg, string(pretty))
 }
+
+// ListAll calls fetch for consecutive pages of pageSize entities, starting at offset 0. fetch keeps the entities
+// of each page and returns how many it got together with the total number of matches reported by the list call.
+// Paging stops at the first empty page or once the total is reached.
+func ListAll(pageSize int64, fetch func(offset, length int64) (int, int64, error)) error {
+	var offset int64
+	for {
+		n, total, err := fetch(offset, pageSize)
+		if err != nil {
+			return err
+		}
+
+		offset += int64(n)
+		if n == 0 || offset >= total {
+			return nil
+		}
+	}
+}
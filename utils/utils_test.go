@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestListAll(t *testing.T) {
+	cases := []struct {
+		name    string
+		total   int64
+		pages   []int
+		offsets []int64
+	}{
+		{"single page", 3, []int{3}, []int64{0}},
+		{"full pages", 4, []int{2, 2}, []int64{0, 2}},
+		{"last page short", 5, []int{2, 2, 1}, []int64{0, 2, 4}},
+		{"empty page before total", 10, []int{2, 0}, []int64{0, 2}},
+		{"no total", 0, []int{2}, []int64{0}},
+	}
+
+	for _, c := range cases {
+		var offsets []int64
+		err := ListAll(2, func(offset, length int64) (int, int64, error) {
+			if length != 2 {
+				t.Fatalf("%s: length = %d, want 2", c.name, length)
+			}
+			if len(offsets) == len(c.pages) {
+				t.Fatalf("%s: fetched more than %d pages", c.name, len(c.pages))
+			}
+			offsets = append(offsets, offset)
+			return c.pages[len(offsets)-1], c.total, nil
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+		if len(offsets) != len(c.offsets) {
+			t.Fatalf("%s: offsets = %v, want %v", c.name, offsets, c.offsets)
+		}
+		for k := range offsets {
+			if offsets[k] != c.offsets[k] {
+				t.Fatalf("%s: offsets = %v, want %v", c.name, offsets, c.offsets)
+			}
+		}
+	}
+}
+
+func TestListAllError(t *testing.T) {
+	want := errors.New("list failed")
+	calls := 0
+	err := ListAll(2, func(offset, length int64) (int, int64, error) {
+		calls++
+		if calls == 2 {
+			return 0, 0, want
+		}
+		return 2, 10, nil
+	})
+	if err != want {
+		t.Fatalf("err = %v, want %v", err, want)
+	}
+	if calls != 2 {
+		t.Fatalf("calls = %d, want 2", calls)
+	}
+}